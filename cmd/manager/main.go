@@ -8,11 +8,10 @@ package main
 import (
 	"context"
 	"fmt"
-	"os"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/metadata"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
@@ -25,48 +24,64 @@ import (
 	"knative.dev/pkg/webhook/configmaps"
 	"knative.dev/pkg/webhook/psbinding"
 	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
 	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	servicebindingclassinformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labs/v1alpha1/servicebindingclass"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
 	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/provisionedservice"
 	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebinding"
 	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebindingprojection"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
+	"github.com/vmware-tanzu/servicebinding/pkg/webhook/binding"
+	"github.com/vmware-tanzu/servicebinding/pkg/webhook/preview"
 )
 
 var (
-	// TODO(scothis) restore labels after https://github.com/vmware-tanzu/servicebinding/issues/130
-	//BindingExcludeLabel can be applied to exclude resource from webhook
-	// BindingExcludeLabel = "bindings.labs.vmware.com/exclude"
-	BindingExcludeLabel = "knative.dev.bindings.labs.vmware.com/exclude"
-	//BindingIncludeLabel can be applied to include resource in webhook
-	// BindingIncludeLabel = "bindings.labs.vmware.com/include"
-	BindingIncludeLabel = "knative.dev.bindings.labs.vmware.com/include"
-
+	// ExclusionSelector keeps the workloads, and namespaces, that are opted
+	// out of being bound with the legacy label away from the binding webhook.
+	//
+	// The webhook reconciler only manages selector expressions on
+	// `knative.dev` labels, keeping other expressions as they are. The
+	// expressions for the selection mode of the binding ConfigMap are
+	// reconciled by the binding webhook instead.
 	ExclusionSelector = metav1.LabelSelector{
 		MatchExpressions: []metav1.LabelSelectorRequirement{{
-			Key:      BindingExcludeLabel,
+			Key:      config.LegacyBindingExcludeLabel,
 			Operator: metav1.LabelSelectorOpNotIn,
 			Values:   []string{"true"},
 		}},
 	}
-	InclusionSelector = metav1.LabelSelector{
-		MatchExpressions: []metav1.LabelSelectorRequirement{{
-			Key:      BindingIncludeLabel,
-			Operator: metav1.LabelSelectorOpIn,
-			Values:   []string{"true"},
-		}},
-	}
 )
+
 var ourTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
-	labsv1alpha1.SchemeGroupVersion.WithKind("ProvisionedService"):                 &labsv1alpha1.ProvisionedService{},
-	servicebindingv1alpha3.SchemeGroupVersion.WithKind("ServiceBinding"):           &servicebindingv1alpha3.ServiceBinding{},
-	servicebindingv1alpha3.SchemeGroupVersion.WithKind("ServiceBindingProjection"): &labsinternalv1alpha1.ServiceBindingProjection{},
+	labsv1alpha1.SchemeGroupVersion.WithKind("ProvisionedService"):                      &labsv1alpha1.ProvisionedService{},
+	labsv1alpha1.SchemeGroupVersion.WithKind("ServiceBindingClass"):                     &labsv1alpha1.ServiceBindingClass{},
+	labsv1alpha1.SchemeGroupVersion.WithKind("ServiceBindingGrant"):                     &labsv1alpha1.ServiceBindingGrant{},
+	servicebindingv1alpha3.SchemeGroupVersion.WithKind("ServiceBinding"):                &servicebindingv1alpha3.ServiceBinding{},
+	servicebindingv1beta1.SchemeGroupVersion.WithKind("ServiceBinding"):                 &servicebindingv1beta1.ServiceBinding{},
+	servicebindingv1beta1.SchemeGroupVersion.WithKind("ClusterWorkloadResourceMapping"): &servicebindingv1beta1.ClusterWorkloadResourceMapping{},
+	servicebindingv1alpha3.SchemeGroupVersion.WithKind("ServiceBindingProjection"):      &labsinternalv1alpha1.ServiceBindingProjection{},
+}
+
+// serviceBindingClasses returns the ServiceBindingClasses in a namespace from
+// the informer cache.
+func serviceBindingClasses(ctx context.Context) labsv1alpha1.ServiceBindingClassesFunc {
+	lister := servicebindingclassinformer.Get(ctx).Lister()
+	return func(namespace string) labsv1alpha1.ServiceBindingClassNamespaceLister {
+		return lister.ServiceBindingClasses(namespace)
+	}
 }
 
 func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	classes := serviceBindingClasses(ctx)
+	serviceLabels := resolver.NewServiceLabelsFunc(ctx, metadata.NewForConfigOrDie(injection.GetConfig(ctx)))
+
 	return defaulting.NewAdmissionController(ctx,
 		// Name of the resource webhook.
 		"defaulting.webhook.bindings.labs.vmware.com",
@@ -79,7 +94,7 @@ func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher
 
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
 		func(ctx context.Context) context.Context {
-			return ctx
+			return labsv1alpha1.WithServiceBindingClasses(ctx, classes, serviceLabels)
 		},
 
 		// Whether to disallow unknown fields.
@@ -88,6 +103,8 @@ func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher
 }
 
 func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	classes := serviceBindingClasses(ctx)
+
 	return validation.NewAdmissionController(ctx,
 		// Name of the resource webhook.
 		"validation.webhook.bindings.labs.vmware.com",
@@ -100,7 +117,7 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
 		func(ctx context.Context) context.Context {
-			return ctx
+			return labsv1alpha1.WithServiceBindingClasses(ctx, classes, nil)
 		},
 
 		// Whether to disallow unknown fields.
@@ -108,6 +125,28 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 	)
 }
 
+func NewConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return conversion.NewConversionController(ctx,
+		// The path on which to serve the webhook
+		"/resource-conversion",
+		// Specify the types of custom resource definitions that should be converted
+		map[schema.GroupKind]conversion.GroupKindConversion{
+			servicebindingv1alpha3.Kind("ServiceBinding"): {
+				DefinitionName: servicebindingv1alpha3.Resource("servicebindings").String(),
+				HubVersion:     servicebindingv1alpha3.SchemeGroupVersion.Version,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					servicebindingv1alpha3.SchemeGroupVersion.Version: &servicebindingv1alpha3.ServiceBinding{},
+					servicebindingv1beta1.SchemeGroupVersion.Version:  &servicebindingv1beta1.ServiceBinding{},
+				},
+			},
+		},
+		// A function that infuses the context passed to ConvertTo/ConvertFrom/SetDefaults with custom metadata
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+	)
+}
+
 func NewConfigValidationController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	return configmaps.NewAdmissionController(ctx,
 
@@ -119,23 +158,21 @@ func NewConfigValidationController(ctx context.Context, cmw configmap.Watcher) *
 
 		// The configmaps to validate.
 		configmap.Constructors{
-			logging.ConfigMapName(): logging.NewConfigFromConfigMap,
-			metrics.ConfigMapName(): metrics.NewObservabilityConfigFromConfigMap,
+			logging.ConfigMapName():       logging.NewConfigFromConfigMap,
+			metrics.ConfigMapName():       metrics.NewObservabilityConfigFromConfigMap,
+			config.BindingConfigName:      config.NewBindingFromConfigMap,
+			config.BindingTypesConfigName: config.NewBindingTypesFromConfigMap,
 		},
 	)
 }
 
 func NewBindingWebhook(resource string, gla psbinding.GetListAll, wcf WithContextFactory) injection.ControllerConstructor {
-	selector := psbinding.WithSelector(ExclusionSelector)
-	if os.Getenv("BINDING_SELECTION_MODE") == "inclusion" {
-		selector = psbinding.WithSelector(InclusionSelector)
-	}
 	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 		var wc psbinding.BindableContext
 		if wcf != nil {
-			wc = wcf(ctx, func(types.NamespacedName) {})
+			wc = wcf(ctx, cmw)
 		}
-		return psbinding.NewAdmissionController(ctx,
+		return binding.NewAdmissionController(ctx, cmw,
 			// Name of the resource webhook.
 			fmt.Sprintf("%s.webhook.bindings.labs.vmware.com", resource),
 
@@ -147,7 +184,7 @@ func NewBindingWebhook(resource string, gla psbinding.GetListAll, wcf WithContex
 
 			// How to setup the context prior to invoking Do/Undo.
 			wc,
-			selector,
+			psbinding.WithSelector(ExclusionSelector),
 		)
 	}
 }
@@ -168,12 +205,26 @@ func main() {
 		NewDefaultingAdmissionController,
 		NewValidationAdmissionController,
 		NewConfigValidationController,
+		NewConversionController,
 
 		// Our reconcilers
 		provisionedservice.NewController,
 		servicebinding.NewController,
-		servicebindingprojection.NewController, NewBindingWebhook("servicebindingprojections", servicebindingprojection.ListAll, nil),
+		servicebindingprojection.NewController, NewBindingWebhook("servicebindingprojections", servicebindingprojection.ListAll, bindableContext),
+
+		// Our preview server, applying bindings like the binding webhook
+		NewPreviewServer(8090, bindableContext),
 	)
 }
 
-type WithContextFactory func(ctx context.Context, handler func(name types.NamespacedName)) psbinding.BindableContext
+func bindableContext(ctx context.Context, cmw configmap.Watcher) psbinding.BindableContext {
+	return servicebindingprojection.NewBindableContext(ctx, cmw)
+}
+
+func NewPreviewServer(port int, wcf WithContextFactory) injection.ControllerConstructor {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		return preview.NewController(ctx, port, wcf(ctx, cmw))
+	}
+}
+
+type WithContextFactory func(ctx context.Context, cmw configmap.Watcher) psbinding.BindableContext
@@ -0,0 +1,250 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+	"github.com/vmware-tanzu/servicebinding/pkg/projector"
+)
+
+const usage = `Usage: servicebinding <command> [flags]
+
+Commands:
+  project         preview ServiceBindings applied to workloads
+  migrate-labels  report or migrate legacy selection labels
+`
+
+const projectUsage = `Usage: servicebinding project [flags]
+
+Applies the ServiceBindings in the input to the workloads in the input, the
+same way the admission webhook does, and writes the projected workloads.
+
+Flags:
+`
+
+const migrateLabelsUsage = `Usage: servicebinding migrate-labels [flags]
+
+Reports the resources in the input, and the pod templates of workloads,
+labeled with the legacy knative.dev.bindings.labs.vmware.com/ selection
+labels. With -migrate, writes the input with the legacy labels replaced by
+the bindings.labs.vmware.com/ labels instead, for example to pipe into
+kubectl apply.
+
+Flags:
+`
+
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	switch args[0] {
+	case "project":
+		return runProject(ctx, args[1:], stdin, stdout, stderr)
+	case "migrate-labels":
+		return runMigrateLabels(args[1:], stdin, stdout, stderr)
+	default:
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+}
+
+func runProject(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var files fileList
+	flags := flag.NewFlagSet("project", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, projectUsage)
+		flags.PrintDefaults()
+	}
+	flags.Var(&files, "f", "file containing ServiceBindings and workloads, '-' reads stdin (may be repeated)")
+	namespace := flags.String("n", "default", "namespace of resources that do not set one")
+	diff := flags.Bool("diff", false, "write a unified diff of each workload instead of the projected workloads")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	objs, err := decodeFiles(files, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+
+	// there is no cluster to discover resources from
+	projections, err := projector.Project(ctx, *namespace, objs, projector.NewOfflineResourceMapper(objs))
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+
+	for i, p := range projections {
+		var out string
+		if *diff {
+			out, err = unifiedDiff(p)
+		} else {
+			out, err = toYAML(p.Projected)
+			if i != 0 {
+				out = "---\n" + out
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return 1
+		}
+		fmt.Fprint(stdout, out)
+	}
+	return 0
+}
+
+func runMigrateLabels(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var files fileList
+	flags := flag.NewFlagSet("migrate-labels", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, migrateLabelsUsage)
+		flags.PrintDefaults()
+	}
+	flags.Var(&files, "f", "file containing resources, '-' reads stdin (may be repeated)")
+	migrate := flags.Bool("migrate", false, "write the resources with the legacy labels migrated, reporting to stderr")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	objs, err := decodeFiles(files, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+
+	report := stdout
+	if *migrate {
+		report = stderr
+	}
+	for i, obj := range objs {
+		for _, path := range [][]string{
+			{"metadata", "labels"},
+			{"spec", "template", "metadata", "labels"},
+		} {
+			labels, found, err := unstructured.NestedStringMap(obj.Object, path...)
+			if err != nil || !found {
+				continue
+			}
+			migrated := config.MigrateLegacyLabels(labels)
+			if len(migrated) == 0 {
+				continue
+			}
+			fmt.Fprintf(report, "%s %s: %s\n", resourceName(obj), strings.Join(path, "."), strings.Join(migrated, ", "))
+			if err := unstructured.SetNestedStringMap(obj.Object, labels, path...); err != nil {
+				fmt.Fprintf(stderr, "error: %v\n", err)
+				return 1
+			}
+		}
+		if !*migrate {
+			continue
+		}
+		out, err := toYAML(obj)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return 1
+		}
+		if i != 0 {
+			out = "---\n" + out
+		}
+		fmt.Fprint(stdout, out)
+	}
+	return 0
+}
+
+func decodeFiles(files fileList, stdin io.Reader) ([]*unstructured.Unstructured, error) {
+	if len(files) == 0 {
+		files = fileList{"-"}
+	}
+	objs := []*unstructured.Unstructured{}
+	for _, file := range files {
+		decoded, err := decodeFile(file, stdin)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, decoded...)
+	}
+	return objs, nil
+}
+
+func decodeFile(file string, stdin io.Reader) ([]*unstructured.Unstructured, error) {
+	if file == "-" {
+		return projector.Decode(stdin)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	objs, err := projector.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return objs, nil
+}
+
+func toYAML(obj *unstructured.Unstructured) (string, error) {
+	b, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unifiedDiff(p projector.Projection) (string, error) {
+	original, err := toYAML(p.Original)
+	if err != nil {
+		return "", err
+	}
+	projected, err := toYAML(p.Projected)
+	if err != nil {
+		return "", err
+	}
+	name := resourceName(p.Original)
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(original),
+		B:        difflib.SplitLines(projected),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+}
+
+func resourceName(obj *unstructured.Unstructured) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return fmt.Sprintf("%s/%s/%s", obj.GetKind(), ns, obj.GetName())
+	}
+	return fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+}
@@ -18,7 +18,7 @@ export GOPATH=${GOPATH:-${TMP_DIR}}
 TMP_REPO_PATH="${TMP_DIR}/src/github.com/vmware-tanzu/servicebinding"
 mkdir -p "$(dirname "${TMP_REPO_PATH}")" && ln -s "${REPO_ROOT}" "${TMP_REPO_PATH}"
 
-API_GROUPS="labs:v1alpha1 labsinternal:v1alpha1 servicebinding:v1alpha3 duck:v1alpha3"
+API_GROUPS="labs:v1alpha1 labsinternal:v1alpha1 servicebinding:v1alpha3,v1beta1 duck:v1alpha3"
 
 # generate the code with:
 # --output-base    because this script should also be able to run inside the vendor dir of
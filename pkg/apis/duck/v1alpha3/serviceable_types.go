@@ -6,7 +6,6 @@ SPDX-License-Identifier: Apache-2.0
 package v1alpha3
 
 import (
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/apis"
@@ -16,7 +15,17 @@ import (
 // +genduck
 
 type Serviceable struct {
-	Binding corev1.LocalObjectReference `json:"binding"`
+	Binding ServiceableBinding `json:"binding"`
+}
+
+// ServiceableBinding references the resource holding the binding data of the
+// service, a Secret unless the service sets the kind.
+type ServiceableBinding struct {
+	// Name of the referent
+	Name string `json:"name,omitempty"`
+	// Kind of the referent, either Secret or ConfigMap. Defaults to Secret
+	// +optional
+	Kind string `json:"kind,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -38,7 +47,7 @@ func (*ServiceableType) GetListType() runtime.Object {
 
 func (t *ServiceableType) Populate() {
 	t.Status = Serviceable{
-		Binding: corev1.LocalObjectReference{Name: "my-secret"},
+		Binding: ServiceableBinding{Name: "my-secret"},
 	}
 }
 
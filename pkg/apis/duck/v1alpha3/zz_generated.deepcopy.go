@@ -31,6 +31,22 @@ func (in *Serviceable) DeepCopy() *Serviceable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceableBinding) DeepCopyInto(out *ServiceableBinding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceableBinding.
+func (in *ServiceableBinding) DeepCopy() *ServiceableBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceableBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceableType) DeepCopyInto(out *ServiceableType) {
 	*out = *in
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/tracker"
+)
+
+// ServiceBindingClassNamespaceLister gets and lists the ServiceBindingClasses
+// in a namespace, as the generated lister does.
+type ServiceBindingClassNamespaceLister interface {
+	List(selector labels.Selector) ([]*ServiceBindingClass, error)
+	Get(name string) (*ServiceBindingClass, error)
+}
+
+// ServiceBindingClassesFunc returns the lister of the ServiceBindingClasses
+// in a namespace.
+type ServiceBindingClassesFunc func(namespace string) ServiceBindingClassNamespaceLister
+
+// ServiceLabelsFunc returns the labels of the service resource.
+type ServiceLabelsFunc func(ctx context.Context, service tracker.Reference) (map[string]string, error)
+
+type serviceBindingClassesKey struct{}
+
+type serviceBindingClasses struct {
+	classes       ServiceBindingClassesFunc
+	serviceLabels ServiceLabelsFunc
+}
+
+// WithServiceBindingClasses sets where the ServiceBindingClass of a
+// ServiceBinding is found while it is defaulted or validated. Classes are
+// only selected by the labels of the service when serviceLabels is set.
+func WithServiceBindingClasses(ctx context.Context, classes ServiceBindingClassesFunc, serviceLabels ServiceLabelsFunc) context.Context {
+	return context.WithValue(ctx, serviceBindingClassesKey{}, serviceBindingClasses{
+		classes:       classes,
+		serviceLabels: serviceLabels,
+	})
+}
+
+// FindServiceBindingClass returns the ServiceBindingClass supplying the
+// defaults of a ServiceBinding in the namespace, nil when there is none or
+// the context does not hold the classes. A named class must exist. Otherwise
+// the first class, by name, whose selector matches the labels of the service
+// is used.
+func FindServiceBindingClass(ctx context.Context, namespace, name string, service *tracker.Reference) (*ServiceBindingClass, error) {
+	classes, ok := ctx.Value(serviceBindingClassesKey{}).(serviceBindingClasses)
+	if !ok || classes.classes == nil {
+		return nil, nil
+	}
+	lister := classes.classes(namespace)
+	if name != "" {
+		class, err := lister.Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ServiceBindingClass %q: %w", name, err)
+		}
+		return class, nil
+	}
+	if service == nil || service.Name == "" || classes.serviceLabels == nil {
+		return nil, nil
+	}
+
+	candidates, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ServiceBindingClasses: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	ref := *service
+	if ref.Namespace == "" {
+		ref.Namespace = namespace
+	}
+	serviceLabels, err := classes.serviceLabels(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Name < candidates[j].Name })
+	for _, c := range candidates {
+		if c.Selects(serviceLabels) {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
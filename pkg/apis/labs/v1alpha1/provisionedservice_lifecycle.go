@@ -28,6 +28,10 @@ func (ps *ProvisionedServiceStatus) MarkReady() {
 	psCondSet.Manage(ps).MarkTrue(ProvisionedServiceConditionReady)
 }
 
+func (ps *ProvisionedServiceStatus) MarkFailed(reason string, message string) {
+	psCondSet.Manage(ps).MarkFalse(ProvisionedServiceConditionReady, reason, "%s", message)
+}
+
 func (ps *ProvisionedServiceStatus) InitializeConditions() {
 	psCondSet.Manage(ps).InitializeConditions()
 }
@@ -55,7 +55,7 @@ func TestProvisionedService_Validate(t *testing.T) {
 		{
 			name:     "empty",
 			seed:     &ProvisionedService{},
-			expected: apis.ErrMissingField("spec.binding.name"),
+			expected: apis.ErrMissingOneOf("spec.binding.name", "spec.sources"),
 		},
 		{
 			name: "valid",
@@ -68,6 +68,126 @@ func TestProvisionedService_Validate(t *testing.T) {
 			},
 			expected: nil,
 		},
+		{
+			name: "valid sources",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Sources: []BindingSource{
+						{
+							Secret: &corev1.LocalObjectReference{Name: "my-secret"},
+						},
+						{
+							ConfigMap: &corev1.LocalObjectReference{Name: "my-config-map"},
+							Items: []BindingSourceItem{
+								{Key: "host"},
+								{Key: "db", Name: "database"},
+							},
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "binding and sources",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Sources: []BindingSource{
+						{
+							Secret: &corev1.LocalObjectReference{Name: "my-secret"},
+						},
+					},
+				},
+			},
+			expected: apis.ErrMultipleOneOf("spec.binding.name", "spec.sources"),
+		},
+		{
+			name: "empty source",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Sources: []BindingSource{
+						{},
+					},
+				},
+			},
+			expected: apis.ErrMissingOneOf("spec.sources[0].secret", "spec.sources[0].configMap"),
+		},
+		{
+			name: "secret and config map source",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Sources: []BindingSource{
+						{
+							Secret:    &corev1.LocalObjectReference{Name: "my-secret"},
+							ConfigMap: &corev1.LocalObjectReference{Name: "my-config-map"},
+						},
+					},
+				},
+			},
+			expected: apis.ErrMultipleOneOf("spec.sources[0].secret", "spec.sources[0].configMap"),
+		},
+		{
+			name: "unnamed sources",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Sources: []BindingSource{
+						{
+							Secret: &corev1.LocalObjectReference{},
+						},
+						{
+							ConfigMap: &corev1.LocalObjectReference{},
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.sources[0].secret.name"),
+				apis.ErrMissingField("spec.sources[1].configMap.name"),
+			),
+		},
+		{
+			name: "invalid items",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Sources: []BindingSource{
+						{
+							Secret: &corev1.LocalObjectReference{Name: "my-secret"},
+							Items: []BindingSourceItem{
+								{},
+								{Key: "not/valid"},
+								{Key: "host", Name: "not/valid"},
+							},
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.sources[0].items[0].key"),
+				apis.ErrInvalidValue("not/valid", "spec.sources[0].items[1].key"),
+				apis.ErrInvalidValue("not/valid", "spec.sources[0].items[2].name"),
+				apis.ErrMultipleOneOf("spec.sources[0].items[1].name", "spec.sources[0].items[2].name"),
+			),
+		},
+		{
+			name: "duplicate item names",
+			seed: &ProvisionedService{
+				Spec: ProvisionedServiceSpec{
+					Sources: []BindingSource{
+						{
+							Secret: &corev1.LocalObjectReference{Name: "my-secret"},
+							Items: []BindingSourceItem{
+								{Key: "host"},
+								{Key: "hostname", Name: "host"},
+							},
+						},
+					},
+				},
+			},
+			expected: apis.ErrMultipleOneOf("spec.sources[0].items[0].name", "spec.sources[0].items[1].name"),
+		},
 	}
 	for _, c := range tests {
 		t.Run(c.name, func(t *testing.T) {
@@ -151,6 +271,27 @@ func TestProvisionedServiceStatus_MarkReady(t *testing.T) {
 	}
 }
 
+func TestProvisionedServiceStatus_MarkFailed(t *testing.T) {
+	expected := &ProvisionedServiceStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{
+				{
+					Type:    apis.ConditionReady,
+					Status:  corev1.ConditionFalse,
+					Reason:  "SourceNotFound",
+					Message: "a message",
+				},
+			},
+		},
+	}
+	actual := &ProvisionedServiceStatus{}
+	actual.MarkFailed("SourceNotFound", "a message")
+
+	if diff := cmp.Diff(expected, actual, cmpopts.IgnoreTypes(apis.VolatileTime{})); diff != "" {
+		t.Errorf("MarkFailed() (-expected, +actual): %s", diff)
+	}
+}
+
 func TestProvisionedServiceStatus_InitializeConditions(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -7,10 +7,12 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
@@ -40,7 +42,37 @@ var (
 )
 
 type ProvisionedServiceSpec struct {
+	// Binding is the Secret exposed by the service
+	// +optional
 	Binding corev1.LocalObjectReference `json:"binding,omitempty"`
+	// Sources are merged into a Secret maintained by the controller, which is
+	// exposed by the service instead of Binding. Entries of later sources
+	// take precedence over entries of earlier sources with the same key
+	// +optional
+	Sources []BindingSource `json:"sources,omitempty"`
+}
+
+// BindingSource is a Secret or ConfigMap whose entries are merged into the
+// binding Secret of a ProvisionedService.
+type BindingSource struct {
+	// Secret to read entries from
+	// +optional
+	Secret *corev1.LocalObjectReference `json:"secret,omitempty"`
+	// ConfigMap to read entries from
+	// +optional
+	ConfigMap *corev1.LocalObjectReference `json:"configMap,omitempty"`
+	// Items select the entries of the source to merge, every entry is merged
+	// when empty
+	// +optional
+	Items []BindingSourceItem `json:"items,omitempty"`
+}
+
+type BindingSourceItem struct {
+	// Key of the entry in the source
+	Key string `json:"key"`
+	// Name of the entry in the binding Secret, defaults to the key
+	// +optional
+	Name string `json:"name,omitempty"`
 }
 
 type ProvisionedServiceStatus struct {
@@ -57,11 +89,83 @@ type ProvisionedServiceList struct {
 }
 
 func (p *ProvisionedService) Validate(ctx context.Context) (errs *apis.FieldError) {
-	if p.Spec.Binding.Name == "" {
+	if p.Spec.Binding.Name == "" && len(p.Spec.Sources) == 0 {
 		errs = errs.Also(
-			apis.ErrMissingField("spec.binding.name"),
+			apis.ErrMissingOneOf("spec.binding.name", "spec.sources"),
 		)
 	}
+	if p.Spec.Binding.Name != "" && len(p.Spec.Sources) != 0 {
+		errs = errs.Also(
+			apis.ErrMultipleOneOf("spec.binding.name", "spec.sources"),
+		)
+	}
+	for i, s := range p.Spec.Sources {
+		errs = errs.Also(
+			s.Validate(ctx).ViaFieldIndex("sources", i).ViaField("spec"),
+		)
+	}
+
+	return errs
+}
+
+func (s *BindingSource) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if s.Secret == nil && s.ConfigMap == nil {
+		errs = errs.Also(
+			apis.ErrMissingOneOf("secret", "configMap"),
+		)
+	}
+	if s.Secret != nil && s.ConfigMap != nil {
+		errs = errs.Also(
+			apis.ErrMultipleOneOf("secret", "configMap"),
+		)
+	}
+	if s.Secret != nil && s.Secret.Name == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("secret.name"),
+		)
+	}
+	if s.ConfigMap != nil && s.ConfigMap.Name == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("configMap.name"),
+		)
+	}
+
+	nameSet := map[string][]int{}
+	for i, item := range s.Items {
+		if item.Key == "" {
+			errs = errs.Also(
+				apis.ErrMissingField(fmt.Sprintf("items[%d].key", i)),
+			)
+		} else if len(validation.IsConfigMapKey(item.Key)) != 0 {
+			errs = errs.Also(
+				apis.ErrInvalidValue(item.Key, fmt.Sprintf("items[%d].key", i)),
+			)
+		}
+		if item.Name != "" && len(validation.IsConfigMapKey(item.Name)) != 0 {
+			errs = errs.Also(
+				apis.ErrInvalidValue(item.Name, fmt.Sprintf("items[%d].name", i)),
+			)
+		}
+		name := item.Name
+		if name == "" {
+			name = item.Key
+		}
+		if name != "" {
+			nameSet[name] = append(nameSet[name], i)
+		}
+	}
+	// look for entries merged under the same name
+	for _, v := range nameSet {
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("items[%d].name", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
 
 	return errs
 }
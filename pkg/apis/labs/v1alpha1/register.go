@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	GroupName = "bindings.labs.vmware.com"
+	GroupName                  = "bindings.labs.vmware.com"
+	ProvisionedServiceLabelKey = GroupName + "/provisionedservice"
 )
 
 var (
@@ -40,6 +41,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ProvisionedService{},
 		&ProvisionedServiceList{},
+		&ServiceBindingClass{},
+		&ServiceBindingClassList{},
+		&ServiceBindingGrant{},
+		&ServiceBindingGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,350 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/tracker"
+)
+
+func TestServiceBindingClass_GetGroupVersionKind(t *testing.T) {
+	if got, want := (&ServiceBindingClass{}).GetGroupVersionKind().String(), "bindings.labs.vmware.com/v1alpha1, Kind=ServiceBindingClass"; got != want {
+		t.Errorf("GetGroupVersionKind() = %v, want %v", got, want)
+	}
+}
+
+func TestServiceBindingClass_SetDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingClass
+		expected *ServiceBindingClass
+	}{
+		{
+			name:     "empty",
+			seed:     &ServiceBindingClass{},
+			expected: &ServiceBindingClass{},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.SetDefaults(context.TODO())
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: SetDefaults() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingClass_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingClass
+		expected *apis.FieldError
+	}{
+		{
+			name:     "empty",
+			seed:     &ServiceBindingClass{},
+			expected: nil,
+		},
+		{
+			name: "valid",
+			seed: &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"database": "postgres"},
+					},
+					Type:     "postgresql",
+					Provider: "bitnami",
+					Env: []labsinternalv1alpha1.EnvVar{
+						{Name: "DB_HOST", Key: "host"},
+					},
+					Containers: []string{"app", "worker-*"},
+					MountPath:  "/etc/db",
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid selector",
+			seed: &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{
+					Selector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "database", Operator: "Like"},
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue(`"Like" is not a valid pod selector operator`, "spec.selector"),
+			),
+		},
+		{
+			name: "invalid type",
+			seed: &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{
+					Type: "postgres sql",
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("postgres sql", "spec.type"),
+			),
+		},
+		{
+			name: "invalid env",
+			seed: &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{
+					Env: []labsinternalv1alpha1.EnvVar{
+						{Name: "DB_HOST"},
+						{Name: "DB_USER", Key: "username"},
+						{Name: "DB_USER", Key: "user"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.env[0].key"),
+				apis.ErrMultipleOneOf("spec.env[1].name", "spec.env[2].name"),
+			),
+		},
+		{
+			name: "invalid containers",
+			seed: &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{
+					Containers: []string{"", "app[", "app"},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.containers[0]"),
+				apis.ErrInvalidArrayValue("app[", "spec.containers", 1),
+			),
+		},
+		{
+			name: "invalid mount path",
+			seed: &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{
+					MountPath: "etc/db",
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("etc/db", "spec.mountPath"),
+			),
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.Validate(context.TODO())
+			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
+				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingClass_Selects(t *testing.T) {
+	tests := []struct {
+		name          string
+		selector      *metav1.LabelSelector
+		serviceLabels map[string]string
+		expected      bool
+	}{
+		{
+			name:          "no selector",
+			serviceLabels: map[string]string{"database": "postgres"},
+			expected:      false,
+		},
+		{
+			name:     "empty selector",
+			selector: &metav1.LabelSelector{},
+			expected: true,
+		},
+		{
+			name: "matching labels",
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"database": "postgres"},
+			},
+			serviceLabels: map[string]string{"database": "postgres", "tier": "gold"},
+			expected:      true,
+		},
+		{
+			name: "other labels",
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"database": "postgres"},
+			},
+			serviceLabels: map[string]string{"database": "mysql"},
+			expected:      false,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			class := &ServiceBindingClass{
+				Spec: ServiceBindingClassSpec{Selector: c.selector},
+			}
+			if actual := class.Selects(c.serviceLabels); actual != c.expected {
+				t.Errorf("%s: Selects() expected %v, got %v", c.name, c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestServiceBindingClass_DefaultEnv(t *testing.T) {
+	class := &ServiceBindingClass{
+		Spec: ServiceBindingClassSpec{
+			Env: []labsinternalv1alpha1.EnvVar{
+				{Name: "DB_HOST", Key: "host"},
+				{Name: "DB_USER", Key: "username"},
+			},
+		},
+	}
+	expected := []labsinternalv1alpha1.EnvVar{
+		{Name: "DB_HOST", Key: "hostname"},
+		{Name: "DB_USER", Key: "username"},
+	}
+	actual := class.DefaultEnv([]labsinternalv1alpha1.EnvVar{
+		{Name: "DB_HOST", Key: "hostname"},
+	})
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("DefaultEnv() (-expected, +actual): %s", diff)
+	}
+}
+
+type fakeServiceBindingClassLister []*ServiceBindingClass
+
+func (l fakeServiceBindingClassLister) classes(namespace string) ServiceBindingClassNamespaceLister {
+	classes := fakeServiceBindingClassLister{}
+	for _, c := range l {
+		if c.Namespace == namespace {
+			classes = append(classes, c)
+		}
+	}
+	return classes
+}
+
+func (l fakeServiceBindingClassLister) List(labels.Selector) ([]*ServiceBindingClass, error) {
+	return l, nil
+}
+
+func (l fakeServiceBindingClassLister) Get(name string) (*ServiceBindingClass, error) {
+	for _, c := range l {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, apierrs.NewNotFound(Resource("servicebindingclasses"), name)
+}
+
+func TestFindServiceBindingClass(t *testing.T) {
+	class := func(namespace, name string, selector *metav1.LabelSelector) *ServiceBindingClass {
+		return &ServiceBindingClass{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       ServiceBindingClassSpec{Selector: selector},
+		}
+	}
+	postgres := class("my-namespace", "postgres", &metav1.LabelSelector{
+		MatchLabels: map[string]string{"database": "postgres"},
+	})
+	fallback := class("my-namespace", "z-fallback", &metav1.LabelSelector{})
+	other := class("other-namespace", "all", &metav1.LabelSelector{})
+	lister := fakeServiceBindingClassLister{fallback, other, postgres}
+	service := &tracker.Reference{
+		APIVersion: "bindings.labs.vmware.com/v1alpha1",
+		Kind:       "ProvisionedService",
+		Name:       "my-service",
+	}
+	labeled := func(serviceLabels map[string]string, err error) ServiceLabelsFunc {
+		return func(ctx context.Context, ref tracker.Reference) (map[string]string, error) {
+			if ref.Namespace != "my-namespace" {
+				return nil, fmt.Errorf("unexpected namespace %q", ref.Namespace)
+			}
+			return serviceLabels, err
+		}
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		class       string
+		service     *tracker.Reference
+		expected    *ServiceBindingClass
+		expectedErr bool
+	}{
+		{
+			name:     "no classes",
+			ctx:      context.TODO(),
+			class:    "postgres",
+			expected: nil,
+		},
+		{
+			name:     "named class",
+			ctx:      WithServiceBindingClasses(context.TODO(), lister.classes, nil),
+			class:    "postgres",
+			expected: postgres,
+		},
+		{
+			name:        "named class in other namespace",
+			ctx:         WithServiceBindingClasses(context.TODO(), lister.classes, nil),
+			class:       "all",
+			expectedErr: true,
+		},
+		{
+			name:        "missing named class is not selected",
+			ctx:         WithServiceBindingClasses(context.TODO(), lister.classes, labeled(map[string]string{"database": "postgres"}, nil)),
+			class:       "missing",
+			service:     service,
+			expectedErr: true,
+		},
+		{
+			name:     "selected by service labels",
+			ctx:      WithServiceBindingClasses(context.TODO(), lister.classes, labeled(map[string]string{"database": "postgres"}, nil)),
+			service:  service,
+			expected: postgres,
+		},
+		{
+			name:     "selected by empty selector",
+			ctx:      WithServiceBindingClasses(context.TODO(), lister.classes, labeled(map[string]string{"database": "mysql"}, nil)),
+			service:  service,
+			expected: fallback,
+		},
+		{
+			name:        "service labels unavailable",
+			ctx:         WithServiceBindingClasses(context.TODO(), lister.classes, labeled(nil, fmt.Errorf("not found"))),
+			service:     service,
+			expectedErr: true,
+		},
+		{
+			name:     "no classes in namespace",
+			ctx:      WithServiceBindingClasses(context.TODO(), fakeServiceBindingClassLister{other}.classes, labeled(nil, fmt.Errorf("not found"))),
+			service:  service,
+			expected: nil,
+		},
+		{
+			name:     "not selected without service labels",
+			ctx:      WithServiceBindingClasses(context.TODO(), lister.classes, nil),
+			service:  service,
+			expected: nil,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := FindServiceBindingClass(c.ctx, "my-namespace", c.class, c.service)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("%s: FindServiceBindingClass() expected err %v, got %v", c.name, c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: FindServiceBindingClass() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
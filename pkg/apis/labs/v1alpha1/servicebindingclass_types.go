@@ -0,0 +1,169 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+)
+
+// ServiceBindingClass supplies defaults to the ServiceBindings in its
+// namespace that name the class, or whose service is selected by the class.
+//
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ServiceBindingClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceBindingClassSpec `json:"spec,omitempty"`
+}
+
+var (
+	// Check that ServiceBindingClass can be validated and defaulted.
+	_ apis.Validatable = (*ServiceBindingClass)(nil)
+	_ apis.Defaultable = (*ServiceBindingClass)(nil)
+)
+
+type ServiceBindingClassSpec struct {
+	// Selector selects the ServiceBindings, by the labels of their service
+	// resource, that don't name a class. An empty selector selects every
+	// service
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Type of the binding, unless set by the ServiceBinding
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Provider of the binding, unless set by the ServiceBinding
+	// +optional
+	Provider string `json:"provider,omitempty"`
+	// Env projects keys from the binding secret into the workload as
+	// environment variables, alongside those of the ServiceBinding. The
+	// ServiceBinding wins when both define a variable
+	// +optional
+	Env []labsinternalv1alpha1.EnvVar `json:"env,omitempty"`
+	// Containers to target within the workload, by name or glob pattern,
+	// unless the ServiceBinding targets containers
+	// +optional
+	Containers []string `json:"containers,omitempty"`
+	// MountPath is the absolute path the binding is mounted at, unless set
+	// by the ServiceBinding
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceBindingClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBindingClass `json:"items"`
+}
+
+func (c *ServiceBindingClass) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if c.Spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(c.Spec.Selector); err != nil {
+			errs = errs.Also(
+				apis.ErrInvalidValue(err.Error(), "spec.selector"),
+			)
+		}
+	}
+
+	if c.Spec.Type != "" {
+		if msgs := validation.IsConfigMapKey(c.Spec.Type); len(msgs) != 0 {
+			errs = errs.Also(
+				apis.ErrInvalidValue(c.Spec.Type, "spec.type"),
+			)
+		}
+	}
+
+	envSet := map[string][]int{}
+	for i, e := range c.Spec.Env {
+		errs = errs.Also(
+			e.Validate(ctx).ViaFieldIndex("env", i).ViaField("spec"),
+		)
+		envSet[e.Name] = append(envSet[e.Name], i)
+	}
+	// look for conflicting names
+	for _, v := range envSet {
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.env[%d].name", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
+
+	for i, p := range c.Spec.Containers {
+		if p == "" {
+			errs = errs.Also(
+				apis.ErrMissingField(apis.CurrentField).ViaFieldIndex("containers", i).ViaField("spec"),
+			)
+		} else if _, err := path.Match(p, ""); err != nil {
+			errs = errs.Also(
+				apis.ErrInvalidArrayValue(p, "containers", i).ViaField("spec"),
+			)
+		}
+	}
+
+	if c.Spec.MountPath != "" && !path.IsAbs(c.Spec.MountPath) {
+		errs = errs.Also(
+			apis.ErrInvalidValue(c.Spec.MountPath, "spec.mountPath"),
+		)
+	}
+
+	return errs
+}
+
+func (c *ServiceBindingClass) SetDefaults(context.Context) {
+	// nothing to do
+}
+
+func (c *ServiceBindingClass) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ServiceBindingClass")
+}
+
+// Selects returns true when the selector of the class matches the labels of
+// a service. A class without a selector selects no service, while an empty
+// selector selects every service.
+func (c *ServiceBindingClass) Selects(serviceLabels map[string]string) bool {
+	if c.Spec.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(c.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(serviceLabels))
+}
+
+// DefaultEnv adds the environment variables of the class not defined by env.
+func (c *ServiceBindingClass) DefaultEnv(env []labsinternalv1alpha1.EnvVar) []labsinternalv1alpha1.EnvVar {
+	defined := map[string]bool{}
+	for _, e := range env {
+		defined[e.Name] = true
+	}
+	for _, e := range c.Spec.Env {
+		if !defined[e.Name] {
+			env = append(env, e)
+		}
+	}
+	return env
+}
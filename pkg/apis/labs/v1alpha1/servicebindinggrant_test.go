@@ -0,0 +1,203 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/tracker"
+)
+
+func TestServiceBindingGrant_GetGroupVersionKind(t *testing.T) {
+	if got, want := (&ServiceBindingGrant{}).GetGroupVersionKind().String(), "bindings.labs.vmware.com/v1alpha1, Kind=ServiceBindingGrant"; got != want {
+		t.Errorf("GetGroupVersionKind() = %v, want %v", got, want)
+	}
+}
+
+func TestServiceBindingGrant_SetDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingGrant
+		expected *ServiceBindingGrant
+	}{
+		{
+			name:     "empty",
+			seed:     &ServiceBindingGrant{},
+			expected: &ServiceBindingGrant{},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.SetDefaults(context.TODO())
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: SetDefaults() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingGrant_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingGrant
+		expected *apis.FieldError
+	}{
+		{
+			name: "empty",
+			seed: &ServiceBindingGrant{},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.from"),
+				apis.ErrMissingField("spec.to"),
+			),
+		},
+		{
+			name: "valid",
+			seed: &ServiceBindingGrant{
+				Spec: ServiceBindingGrantSpec{
+					From: []ServiceBindingGrantFrom{
+						{Namespace: "my-namespace"},
+					},
+					To: []ServiceBindingGrantTo{
+						{Group: "bindings.labs.vmware.com", Kind: "ProvisionedService"},
+						{Kind: "Secret", Name: "my-secret"},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid entries",
+			seed: &ServiceBindingGrant{
+				Spec: ServiceBindingGrantSpec{
+					From: []ServiceBindingGrantFrom{
+						{},
+						{Namespace: "My_Namespace"},
+					},
+					To: []ServiceBindingGrantTo{
+						{Name: "my-secret"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.from[0].namespace"),
+				apis.ErrInvalidValue("My_Namespace", "spec.from[1].namespace"),
+				apis.ErrMissingField("spec.to[0].kind"),
+			),
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.Validate(context.TODO())
+			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
+				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingGrant_Grants(t *testing.T) {
+	grant := &ServiceBindingGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "service-namespace",
+			Name:      "my-grant",
+		},
+		Spec: ServiceBindingGrantSpec{
+			From: []ServiceBindingGrantFrom{
+				{Namespace: "my-namespace"},
+			},
+			To: []ServiceBindingGrantTo{
+				{Group: "bindings.labs.vmware.com", Kind: "ProvisionedService"},
+				{Kind: "Secret", Name: "my-secret"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		service   tracker.Reference
+		expected  bool
+	}{
+		{
+			name:      "any name of kind",
+			namespace: "my-namespace",
+			service: tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Namespace:  "service-namespace",
+				Name:       "my-service",
+			},
+			expected: true,
+		},
+		{
+			name:      "named resource",
+			namespace: "my-namespace",
+			service: tracker.Reference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "service-namespace",
+				Name:       "my-secret",
+			},
+			expected: true,
+		},
+		{
+			name:      "other name",
+			namespace: "my-namespace",
+			service: tracker.Reference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "service-namespace",
+				Name:       "other-secret",
+			},
+			expected: false,
+		},
+		{
+			name:      "other group",
+			namespace: "my-namespace",
+			service: tracker.Reference{
+				APIVersion: "example.com/v1",
+				Kind:       "ProvisionedService",
+				Namespace:  "service-namespace",
+				Name:       "my-service",
+			},
+			expected: false,
+		},
+		{
+			name:      "other from namespace",
+			namespace: "other-namespace",
+			service: tracker.Reference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "service-namespace",
+				Name:       "my-secret",
+			},
+			expected: false,
+		},
+		{
+			name:      "service in other namespace",
+			namespace: "my-namespace",
+			service: tracker.Reference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Namespace:  "other-namespace",
+				Name:       "my-secret",
+			},
+			expected: false,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := grant.Grants(c.namespace, c.service); actual != c.expected {
+				t.Errorf("%s: Grants() = %v, want %v", c.name, actual, c.expected)
+			}
+		})
+	}
+}
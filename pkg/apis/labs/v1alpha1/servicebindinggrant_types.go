@@ -0,0 +1,134 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/tracker"
+)
+
+// ServiceBindingGrant authorizes ServiceBindings in other namespaces to
+// reference services in the grant's namespace.
+//
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ServiceBindingGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceBindingGrantSpec `json:"spec,omitempty"`
+}
+
+var (
+	// Check that ServiceBindingGrant can be validated and defaulted.
+	_ apis.Validatable = (*ServiceBindingGrant)(nil)
+	_ apis.Defaultable = (*ServiceBindingGrant)(nil)
+)
+
+type ServiceBindingGrantSpec struct {
+	// From lists the namespaces whose ServiceBindings may reference the
+	// services
+	From []ServiceBindingGrantFrom `json:"from"`
+	// To lists the services in this namespace that may be referenced
+	To []ServiceBindingGrantTo `json:"to"`
+}
+
+type ServiceBindingGrantFrom struct {
+	// Namespace of the referencing ServiceBindings
+	Namespace string `json:"namespace"`
+}
+
+type ServiceBindingGrantTo struct {
+	// Group of the service resource, empty for the core API group
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind of the service resource
+	Kind string `json:"kind"`
+	// Name of the service resource, every resource of the kind is granted
+	// when empty
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceBindingGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBindingGrant `json:"items"`
+}
+
+func (g *ServiceBindingGrant) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if len(g.Spec.From) == 0 {
+		errs = errs.Also(
+			apis.ErrMissingField("spec.from"),
+		)
+	}
+	for i, from := range g.Spec.From {
+		if from.Namespace == "" {
+			errs = errs.Also(
+				apis.ErrMissingField("namespace").ViaFieldIndex("from", i).ViaField("spec"),
+			)
+		} else if msgs := validation.IsDNS1123Label(from.Namespace); len(msgs) != 0 {
+			errs = errs.Also(
+				apis.ErrInvalidValue(from.Namespace, "namespace").ViaFieldIndex("from", i).ViaField("spec"),
+			)
+		}
+	}
+	if len(g.Spec.To) == 0 {
+		errs = errs.Also(
+			apis.ErrMissingField("spec.to"),
+		)
+	}
+	for i, to := range g.Spec.To {
+		if to.Kind == "" {
+			errs = errs.Also(
+				apis.ErrMissingField("kind").ViaFieldIndex("to", i).ViaField("spec"),
+			)
+		}
+	}
+
+	return errs
+}
+
+func (g *ServiceBindingGrant) SetDefaults(context.Context) {
+	// nothing to do
+}
+
+func (g *ServiceBindingGrant) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ServiceBindingGrant")
+}
+
+// Grants returns true when ServiceBindings in the namespace are allowed to
+// reference the service. The service must be in the grant's namespace.
+func (g *ServiceBindingGrant) Grants(namespace string, service tracker.Reference) bool {
+	if service.Namespace != g.Namespace {
+		return false
+	}
+	from := false
+	for _, f := range g.Spec.From {
+		if f.Namespace == namespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+	group := service.GroupVersionKind().Group
+	for _, t := range g.Spec.To {
+		if t.Group == group && t.Kind == service.Kind && (t.Name == "" || t.Name == service.Name) {
+			return true
+		}
+	}
+	return false
+}
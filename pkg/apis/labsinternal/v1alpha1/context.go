@@ -0,0 +1,27 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import "context"
+
+// DefaultMountRoot is the directory bindings are mounted under when neither
+// the container nor the context define a mount root.
+const DefaultMountRoot = "/bindings"
+
+type mountRootKey struct{}
+
+// WithMountRoot sets the directory bindings are mounted under for containers
+// that do not define SERVICE_BINDING_ROOT.
+func WithMountRoot(ctx context.Context, root string) context.Context {
+	return context.WithValue(ctx, mountRootKey{}, root)
+}
+
+func mountRootFromContext(ctx context.Context) string {
+	if root, ok := ctx.Value(mountRootKey{}).(string); ok && root != "" {
+		return root
+	}
+	return DefaultMountRoot
+}
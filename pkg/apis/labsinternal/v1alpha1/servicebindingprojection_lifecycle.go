@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"fmt"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
@@ -24,6 +25,12 @@ import (
 const (
 	ServiceBindingProjectionConditionReady             = apis.ConditionReady
 	ServiceBindingProjectionConditionWorkloadAvailable = "WorkloadAvailable"
+	// ServiceBindingProjectionConditionUnbound is only present while the
+	// binding is removed from the workloads
+	ServiceBindingProjectionConditionUnbound = "Unbound"
+	// ServiceBindingProjectionConditionContainersMatched is only present
+	// when the workload reference names the containers to target
+	ServiceBindingProjectionConditionContainersMatched = "ContainersMatched"
 
 	ServiceBindingRootEnv = "SERVICE_BINDING_ROOT"
 	bindingVolumePrefix   = "binding-"
@@ -59,63 +66,13 @@ func (b *ServiceBindingProjection) Do(ctx context.Context, ps *duckv1.WithPod) {
 	injectedSecrets, injectedVolumes := b.injectedValues(ps)
 	key := b.annotationKey()
 
-	sb := b.Spec.Binding
-
-	volume := corev1.Volume{
-		Name: fmt.Sprintf("%s%x", bindingVolumePrefix, sha1.Sum([]byte(sb.Name))),
-		VolumeSource: corev1.VolumeSource{
-			Projected: &corev1.ProjectedVolumeSource{
-				Sources: []corev1.VolumeProjection{
-					{
-						Secret: &corev1.SecretProjection{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: sb.Name,
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-	if b.Spec.Type != "" {
-		typeAnnotation := fmt.Sprintf("%s-type", key)
-		ps.Spec.Template.Annotations[typeAnnotation] = b.Spec.Type
-		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
-			corev1.VolumeProjection{
-				DownwardAPI: &corev1.DownwardAPIProjection{
-					Items: []corev1.DownwardAPIVolumeFile{
-						{
-							FieldRef: &corev1.ObjectFieldSelector{
-								FieldPath: fmt.Sprintf("metadata.annotations['%s']", typeAnnotation),
-							},
-							Path: "type",
-						},
-					},
-				},
-			},
-		)
-	}
-	if b.Spec.Provider != "" {
-		providerAnnotation := fmt.Sprintf("%s-provider", key)
-		ps.Spec.Template.Annotations[providerAnnotation] = b.Spec.Provider
-		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
-			corev1.VolumeProjection{
-				DownwardAPI: &corev1.DownwardAPIProjection{
-					Items: []corev1.DownwardAPIVolumeFile{
-						{
-							FieldRef: &corev1.ObjectFieldSelector{
-								FieldPath: fmt.Sprintf("metadata.annotations['%s']", providerAnnotation),
-							},
-							Path: "provider",
-						},
-					},
-				},
-			},
-		)
+	dirs := b.directories()
+	for _, d := range dirs {
+		volume := d.volume(ps)
+		ps.Spec.Template.Spec.Volumes = append(ps.Spec.Template.Spec.Volumes, volume)
+		injectedSecrets.Insert(d.secretName)
+		injectedVolumes.Insert(volume.Name)
 	}
-	ps.Spec.Template.Spec.Volumes = append(ps.Spec.Template.Spec.Volumes, volume)
-	injectedSecrets.Insert(sb.Name)
-	injectedVolumes.Insert(volume.Name)
 	sort.SliceStable(ps.Spec.Template.Spec.Volumes, func(i, j int) bool {
 		iname := ps.Spec.Template.Spec.Volumes[i].Name
 		jname := ps.Spec.Template.Spec.Volumes[j].Name
@@ -125,48 +82,47 @@ func (b *ServiceBindingProjection) Do(ctx context.Context, ps *duckv1.WithPod) {
 		}
 		return iname < jname
 	})
-	// track which secret is injected, so it can be removed when no longer used
-	ps.Annotations[key] = sb.Name
-
-	for i := range ps.Spec.Template.Spec.InitContainers {
-		c := &ps.Spec.Template.Spec.InitContainers[i]
-		if b.isTargetContainer(-1, c) {
-			b.doContainer(ctx, ps, c, volume.Name, sb.Name, injectedVolumes, injectedSecrets)
-		}
+	// track which secrets are injected, so they can be removed when no
+	// longer used
+	ps.Annotations[key] = strings.Join(b.ProjectedSecretNames(), ",")
+	if b.Spec.RolloutOnSecretChange && b.Status.SecretHash != "" {
+		// a new hash changes the pod template, rolling out the workload
+		ps.Spec.Template.Annotations[fmt.Sprintf("%s-secret-hash", key)] = b.Status.SecretHash
 	}
-	for i := range ps.Spec.Template.Spec.Containers {
-		c := &ps.Spec.Template.Spec.Containers[i]
-		if b.isTargetContainer(i, c) {
-			b.doContainer(ctx, ps, c, volume.Name, sb.Name, injectedVolumes, injectedSecrets)
+
+	visitContainers(ps, func(kind containerKind, c *corev1.Container) {
+		if b.isTargetContainer(kind, c) {
+			b.doContainer(ctx, ps, c, dirs, injectedVolumes, injectedSecrets)
 		}
-	}
+	})
 }
 
-func (b *ServiceBindingProjection) doContainer(ctx context.Context, ps *duckv1.WithPod, c *corev1.Container, bindingVolume, secretName string, allInjectedVolumes, allInjectedSecrets sets.String) {
+func (b *ServiceBindingProjection) doContainer(ctx context.Context, ps *duckv1.WithPod, c *corev1.Container, dirs []bindingDirectory, allInjectedVolumes, allInjectedSecrets sets.String) {
 	key := b.annotationKey()
-	mountPath := ""
-	// lookup predefined mount path
-	for _, e := range c.Env {
-		if e.Name == ServiceBindingRootEnv {
-			mountPath = e.Value
-			break
-		}
-	}
-	if mountPath == "" {
-		// default mount path
-		mountPath = "/bindings"
+	secretName := b.ProjectedSecretName()
+	// lookup predefined mount root
+	root := containerMountRoot(c)
+	if root == "" {
+		// default mount root
+		root = mountRootFromContext(ctx)
 		c.Env = append(c.Env, corev1.EnvVar{
 			Name:  ServiceBindingRootEnv,
-			Value: mountPath,
+			Value: root,
 		})
 	}
 
-	// inject metadata
-	c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
-		Name:      bindingVolume,
-		MountPath: fmt.Sprintf("%s/%s", mountPath, b.Spec.Name),
-		ReadOnly:  true,
-	})
+	// inject metadata, unless another volume is mounted at the same path.
+	// The collision is reported by MountPathCollisions
+	for _, d := range dirs {
+		mountPath := d.mountPath(root)
+		if !hasCollidingVolumeMount(c, mountPath, d.volumeName) {
+			c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+				Name:      d.volumeName,
+				MountPath: mountPath,
+				ReadOnly:  true,
+			})
+		}
+	}
 	sort.SliceStable(c.VolumeMounts, func(i, j int) bool {
 		iname := c.VolumeMounts[i].Name
 		jname := c.VolumeMounts[j].Name
@@ -203,6 +159,20 @@ func (b *ServiceBindingProjection) doContainer(ctx context.Context, ps *duckv1.W
 				})
 				continue
 			}
+			if b.Spec.BindingKind == BindingKindConfigMap {
+				c.Env = append(c.Env, corev1.EnvVar{
+					Name: e.Name,
+					ValueFrom: &corev1.EnvVarSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: secretName,
+							},
+							Key: e.Key,
+						},
+					},
+				})
+				continue
+			}
 			c.Env = append(c.Env, corev1.EnvVar{
 				Name: e.Name,
 				ValueFrom: &corev1.EnvVarSource{
@@ -227,13 +197,270 @@ func (b *ServiceBindingProjection) doContainer(ctx context.Context, ps *duckv1.W
 	}
 }
 
-func (b *ServiceBindingProjection) isTargetContainer(idx int, c *corev1.Container) bool {
-	targets := b.Spec.Workload.Containers
-	if len(targets) == 0 {
+// MountPathCollisions returns the names of the targeted containers that
+// mount another volume at the path the binding is mounted at. The binding is
+// not mounted into these containers by Do, as the pod spec would be invalid.
+func (b *ServiceBindingProjection) MountPathCollisions(ctx context.Context, ps *duckv1.WithPod) []string {
+	var collisions []string
+	dirs := b.directories()
+	visitContainers(ps, func(kind containerKind, c *corev1.Container) {
+		if !b.isTargetContainer(kind, c) {
+			return
+		}
+		root := containerMountRoot(c)
+		if root == "" {
+			root = mountRootFromContext(ctx)
+		}
+		for _, d := range dirs {
+			if hasCollidingVolumeMount(c, d.mountPath(root), d.volumeName) {
+				collisions = append(collisions, c.Name)
+				return
+			}
+		}
+	})
+	return collisions
+}
+
+// InjectedContainers returns the names of the containers, init containers and
+// ephemeral containers the binding is mounted into.
+func (b *ServiceBindingProjection) InjectedContainers(ps *duckv1.WithPod) []string {
+	var injected []string
+	bindingVolumes := sets.NewString()
+	for _, d := range b.directories() {
+		bindingVolumes.Insert(d.volumeName)
+	}
+	visitContainers(ps, func(_ containerKind, c *corev1.Container) {
+		for _, vm := range c.VolumeMounts {
+			if bindingVolumes.Has(vm.Name) {
+				injected = append(injected, c.Name)
+				return
+			}
+		}
+	})
+	return injected
+}
+
+// MissingContainers returns the container names and patterns targeted by the
+// workload reference that do not match any container defined by the
+// workload. Containers are matched against the containers and init
+// containers, IncludeInitContainers against the init containers and
+// IncludeEphemeralContainers against the ephemeral containers.
+func (b *ServiceBindingProjection) MissingContainers(ps *duckv1.WithPod) []string {
+	defined := map[containerKind][]string{}
+	visitContainers(ps, func(kind containerKind, c *corev1.Container) {
+		defined[kind] = append(defined[kind], c.Name)
+	})
+	var missing []string
+	check := func(patterns []string, kinds ...containerKind) {
+		var names []string
+		for _, kind := range kinds {
+			names = append(names, defined[kind]...)
+		}
+	patterns:
+		for _, p := range patterns {
+			for _, name := range names {
+				if ok, _ := path.Match(p, name); ok {
+					continue patterns
+				}
+			}
+			missing = append(missing, p)
+		}
+	}
+	w := b.Spec.Workload
+	check(w.Containers, initContainer, appContainer)
+	check(w.IncludeInitContainers, initContainer)
+	check(w.IncludeEphemeralContainers, ephemeralContainer)
+	return missing
+}
+
+// bindingDirectory is a directory of the binding root projected into the
+// workload from a secret or config map, along with its type and provider.
+type bindingDirectory struct {
+	name       string
+	secretName string
+	// configMap is true when secretName is the name of a ConfigMap
+	configMap  bool
+	volumeName string
+	// annotationKey prefixes the pod template annotations exposing the type
+	// and provider
+	annotationKey string
+	typ           string
+	provider      string
+	// customMountPath overrides the path within the mount root
+	customMountPath string
+}
+
+// directories returns the directory of the binding followed by the
+// directory of each of the services projected alongside it.
+func (b *ServiceBindingProjection) directories() []bindingDirectory {
+	dirs := []bindingDirectory{{
+		name:            b.Spec.Name,
+		secretName:      b.ProjectedSecretName(),
+		configMap:       b.Spec.BindingKind == BindingKindConfigMap,
+		volumeName:      fmt.Sprintf("%s%x", bindingVolumePrefix, sha1.Sum([]byte(b.ProjectedSecretName()))),
+		annotationKey:   b.annotationKey(),
+		typ:             b.Spec.Type,
+		provider:        b.Spec.Provider,
+		customMountPath: b.Spec.MountPath,
+	}}
+	for _, s := range b.Spec.Services {
+		// services are keyed by name, as several may share a secret
+		id := sha1.Sum([]byte(fmt.Sprintf("%s/%s", b.Name, s.Name)))
+		dirs = append(dirs, bindingDirectory{
+			name:          s.Name,
+			secretName:    s.Binding.Name,
+			volumeName:    fmt.Sprintf("%s%x", bindingVolumePrefix, id),
+			annotationKey: fmt.Sprintf("%s-%x", ServiceBindingProjectionAnnotationKey, id),
+			typ:           s.Type,
+			provider:      s.Provider,
+		})
+	}
+	return dirs
+}
+
+// mountPath is the path the directory is mounted at for a mount root.
+func (d bindingDirectory) mountPath(root string) string {
+	if d.customMountPath != "" {
+		return d.customMountPath
+	}
+	return fmt.Sprintf("%s/%s", root, d.name)
+}
+
+// volume projects the secret or config map of the directory, exposing the
+// type and provider from annotations on the pod template.
+func (d bindingDirectory) volume(ps *duckv1.WithPod) corev1.Volume {
+	source := corev1.VolumeProjection{
+		Secret: &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: d.secretName,
+			},
+		},
+	}
+	if d.configMap {
+		source = corev1.VolumeProjection{
+			ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: d.secretName,
+				},
+			},
+		}
+	}
+	volume := corev1.Volume{
+		Name: d.volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{source},
+			},
+		},
+	}
+	if d.typ != "" {
+		typeAnnotation := fmt.Sprintf("%s-type", d.annotationKey)
+		ps.Spec.Template.Annotations[typeAnnotation] = d.typ
+		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
+			corev1.VolumeProjection{
+				DownwardAPI: &corev1.DownwardAPIProjection{
+					Items: []corev1.DownwardAPIVolumeFile{
+						{
+							FieldRef: &corev1.ObjectFieldSelector{
+								FieldPath: fmt.Sprintf("metadata.annotations['%s']", typeAnnotation),
+							},
+							Path: "type",
+						},
+					},
+				},
+			},
+		)
+	}
+	if d.provider != "" {
+		providerAnnotation := fmt.Sprintf("%s-provider", d.annotationKey)
+		ps.Spec.Template.Annotations[providerAnnotation] = d.provider
+		volume.VolumeSource.Projected.Sources = append(volume.VolumeSource.Projected.Sources,
+			corev1.VolumeProjection{
+				DownwardAPI: &corev1.DownwardAPIProjection{
+					Items: []corev1.DownwardAPIVolumeFile{
+						{
+							FieldRef: &corev1.ObjectFieldSelector{
+								FieldPath: fmt.Sprintf("metadata.annotations['%s']", providerAnnotation),
+							},
+							Path: "provider",
+						},
+					},
+				},
+			},
+		)
+	}
+	return volume
+}
+
+// containerMountRoot returns the mount root defined by the container's
+// SERVICE_BINDING_ROOT environment variable, if any.
+func containerMountRoot(c *corev1.Container) string {
+	for _, e := range c.Env {
+		if e.Name == ServiceBindingRootEnv {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+func hasCollidingVolumeMount(c *corev1.Container, mountPath, bindingVolume string) bool {
+	for _, vm := range c.VolumeMounts {
+		if vm.Name != bindingVolume && path.Clean(vm.MountPath) == path.Clean(mountPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// containerKind is the list of the pod spec a container is defined in
+type containerKind int
+
+const (
+	appContainer containerKind = iota
+	initContainer
+	ephemeralContainer
+)
+
+// visitContainers calls fn for each init container, container and ephemeral
+// container of the pod spec, in that order.
+func visitContainers(ps *duckv1.WithPod, fn func(kind containerKind, c *corev1.Container)) {
+	spec := &ps.Spec.Template.Spec
+	for i := range spec.InitContainers {
+		fn(initContainer, &spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		fn(appContainer, &spec.Containers[i])
+	}
+	for i := range spec.EphemeralContainers {
+		// EphemeralContainerCommon mirrors the fields of Container
+		fn(ephemeralContainer, (*corev1.Container)(&spec.EphemeralContainers[i].EphemeralContainerCommon))
+	}
+}
+
+func (b *ServiceBindingProjection) isTargetContainer(kind containerKind, c *corev1.Container) bool {
+	w := b.Spec.Workload
+	if matchesContainer(w.ExcludeContainers, c.Name) {
+		return false
+	}
+	switch kind {
+	case ephemeralContainer:
+		return matchesContainer(w.IncludeEphemeralContainers, c.Name)
+	case initContainer:
+		if len(w.IncludeInitContainers) != 0 {
+			return matchesContainer(w.IncludeInitContainers, c.Name)
+		}
+	}
+	if len(w.Containers) == 0 {
 		return true
 	}
-	for _, t := range targets {
-		if c.Name == t {
+	return matchesContainer(w.Containers, c.Name)
+}
+
+// matchesContainer returns true if the container name matches any of the
+// names or glob patterns.
+func matchesContainer(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
 			return true
 		}
 	}
@@ -249,30 +476,34 @@ func (b *ServiceBindingProjection) Undo(ctx context.Context, ps *duckv1.WithPod)
 	}
 
 	key := b.annotationKey()
-	removeSecrets := sets.NewString(ps.Annotations[key], b.Spec.Binding.Name)
+	removeSecrets := sets.NewString(strings.Split(ps.Annotations[key], ",")...)
+	for _, d := range b.directories() {
+		removeSecrets.Insert(d.secretName)
+	}
+	removeSecrets.Insert(b.Spec.Binding.Name)
 	removeVolumes := sets.NewString()
 	delete(ps.Annotations, key)
 	delete(ps.Spec.Template.Annotations, fmt.Sprintf("%s-type", key))
 	delete(ps.Spec.Template.Annotations, fmt.Sprintf("%s-provider", key))
+	delete(ps.Spec.Template.Annotations, fmt.Sprintf("%s-secret-hash", key))
 
 	preservedVolumes := []corev1.Volume{}
 	for _, v := range ps.Spec.Template.Spec.Volumes {
-		if v.Projected != nil && len(v.Projected.Sources) > 0 &&
-			v.Projected.Sources[0].Secret != nil &&
-			removeSecrets.Has(v.Projected.Sources[0].Secret.Name) {
+		if name, ok := bindingVolumeSource(v); ok && removeSecrets.Has(name) {
 			removeVolumes.Insert(v.Name)
+			// remove the type and provider of services no longer projected
+			for _, annotation := range volumeAnnotations(v) {
+				delete(ps.Spec.Template.Annotations, annotation)
+			}
 			continue
 		}
 		preservedVolumes = append(preservedVolumes, v)
 	}
 	ps.Spec.Template.Spec.Volumes = preservedVolumes
 
-	for i := range ps.Spec.Template.Spec.InitContainers {
-		b.undoContainer(ctx, ps, &ps.Spec.Template.Spec.InitContainers[i], removeSecrets, removeVolumes)
-	}
-	for i := range ps.Spec.Template.Spec.Containers {
-		b.undoContainer(ctx, ps, &ps.Spec.Template.Spec.Containers[i], removeSecrets, removeVolumes)
-	}
+	visitContainers(ps, func(_ containerKind, c *corev1.Container) {
+		b.undoContainer(ctx, ps, c, removeSecrets, removeVolumes)
+	})
 }
 
 func (b *ServiceBindingProjection) undoContainer(ctx context.Context, ps *duckv1.WithPod, c *corev1.Container, removeSecrets, removeVolumes sets.String) {
@@ -293,6 +524,34 @@ func (b *ServiceBindingProjection) undoContainer(ctx context.Context, ps *duckv1
 	c.Env = preservedEnv
 }
 
+// ProjectedSecretName is the name of the Secret projected into the workload,
+// or of the ConfigMap for a ConfigMap binding. When mappings are set, the
+// mapped copy of the binding secret maintained by the controller is
+// projected.
+func (b *ServiceBindingProjection) ProjectedSecretName() string {
+	if len(b.Spec.Mappings) == 0 {
+		return b.Spec.Binding.Name
+	}
+	return b.MappedSecretName()
+}
+
+// ProjectedSecretNames are the names of the Secrets projected into the
+// workload, the projected secret followed by the binding secret of each of
+// the services.
+func (b *ServiceBindingProjection) ProjectedSecretNames() []string {
+	dirs := b.directories()
+	names := make([]string, len(dirs))
+	for i, d := range dirs {
+		names[i] = d.secretName
+	}
+	return names
+}
+
+// MappedSecretName is the name of the mapped copy of the binding secret.
+func (b *ServiceBindingProjection) MappedSecretName() string {
+	return fmt.Sprintf("%s-mapped", b.Name)
+}
+
 func (b *ServiceBindingProjection) annotationKey() string {
 	return fmt.Sprintf("%s-%x", ServiceBindingProjectionAnnotationKey, sha1.Sum([]byte(b.Name)))
 }
@@ -302,25 +561,64 @@ func (b *ServiceBindingProjection) injectedValues(ps *duckv1.WithPod) (sets.Stri
 	volumes := sets.NewString()
 	for k, v := range ps.Annotations {
 		if strings.HasPrefix(k, ServiceBindingProjectionAnnotationKey) {
-			secrets.Insert(v)
+			secrets.Insert(strings.Split(v, ",")...)
 		}
 	}
 	for _, v := range ps.Spec.Template.Spec.Volumes {
-		if v.Projected != nil && len(v.Projected.Sources) > 0 &&
-			v.Projected.Sources[0].Secret != nil &&
-			secrets.Has(v.Projected.Sources[0].Secret.Name) {
+		if name, ok := bindingVolumeSource(v); ok && secrets.Has(name) {
 			volumes.Insert(v.Name)
 		}
 	}
 	return secrets, volumes
 }
 
+// bindingVolumeSource returns the name of the Secret or ConfigMap projected
+// first by a volume, the source of binding volumes.
+func bindingVolumeSource(v corev1.Volume) (string, bool) {
+	if v.Projected == nil || len(v.Projected.Sources) == 0 {
+		return "", false
+	}
+	source := v.Projected.Sources[0]
+	if source.Secret != nil {
+		return source.Secret.Name, true
+	}
+	if source.ConfigMap != nil {
+		return source.ConfigMap.Name, true
+	}
+	return "", false
+}
+
+var volumeAnnotationRe = regexp.MustCompile(fmt.Sprintf(`^metadata\.annotations\['(%s-[0-9a-f]+-(?:type|provider))'\]$`, regexp.QuoteMeta(ServiceBindingProjectionAnnotationKey)))
+
+// volumeAnnotations returns the pod template annotations exposed by the
+// downward API items of a binding volume.
+func volumeAnnotations(v corev1.Volume) []string {
+	var annotations []string
+	for _, source := range v.Projected.Sources {
+		if source.DownwardAPI == nil {
+			continue
+		}
+		for _, item := range source.DownwardAPI.Items {
+			if item.FieldRef == nil {
+				continue
+			}
+			if m := volumeAnnotationRe.FindStringSubmatch(item.FieldRef.FieldPath); m != nil {
+				annotations = append(annotations, m[1])
+			}
+		}
+	}
+	return annotations
+}
+
 var fieldPathAnnotationRe = regexp.MustCompile(fmt.Sprintf(`^%s[0-9a-f]+%s(type|provider)%s$`, regexp.QuoteMeta(fmt.Sprintf("metadata.annotations['%s-", ServiceBindingProjectionAnnotationKey)), "-", "']"))
 
 func (b *ServiceBindingProjection) isInjectedEnv(e corev1.EnvVar, allInjectedSecrets sets.String) bool {
 	if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && allInjectedSecrets.Has(e.ValueFrom.SecretKeyRef.Name) {
 		return true
 	}
+	if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil && allInjectedSecrets.Has(e.ValueFrom.ConfigMapKeyRef.Name) {
+		return true
+	}
 	if e.ValueFrom != nil && e.ValueFrom.FieldRef != nil && fieldPathAnnotationRe.MatchString(e.ValueFrom.FieldRef.FieldPath) {
 		return true
 	}
@@ -344,6 +642,60 @@ func (bs *ServiceBindingProjectionStatus) MarkBindingUnavailable(reason string,
 		ServiceBindingProjectionConditionWorkloadAvailable, reason, message)
 }
 
+func (bs *ServiceBindingProjectionStatus) MarkUnbinding(reason string, message string) {
+	sbpCondSet.Manage(bs).MarkFalse(
+		ServiceBindingProjectionConditionUnbound, reason, message)
+}
+
+// MarkContainersMatched reports that every container named by the workload
+// reference is defined by the workloads.
+func (bs *ServiceBindingProjectionStatus) MarkContainersMatched() {
+	sbpCondSet.Manage(bs).MarkTrue(ServiceBindingProjectionConditionContainersMatched)
+}
+
+// MarkContainersNotMatched reports containers named by the workload reference
+// that are not defined by a workload, and are not bound.
+func (bs *ServiceBindingProjectionStatus) MarkContainersNotMatched(reason string, message string) {
+	sbpCondSet.Manage(bs).MarkFalse(
+		ServiceBindingProjectionConditionContainersMatched, reason, message)
+}
+
+// ClearContainersMatched removes the ContainersMatched condition, for
+// bindings that target every container.
+func (bs *ServiceBindingProjectionStatus) ClearContainersMatched() {
+	_ = sbpCondSet.Manage(bs).ClearCondition(ServiceBindingProjectionConditionContainersMatched)
+}
+
 func (bs *ServiceBindingProjectionStatus) SetObservedGeneration(gen int64) {
 	bs.ObservedGeneration = gen
 }
+
+func (bs *ServiceBindingProjectionStatus) SetWorkloads(workloads []WorkloadStatus) {
+	sort.SliceStable(workloads, func(i, j int) bool {
+		return workloads[i].Name < workloads[j].Name
+	})
+	bs.Workloads = workloads
+}
+
+// SetSecretHash records the hash of the projected secret's data applied to
+// the workloads.
+func (bs *ServiceBindingProjectionStatus) SetSecretHash(hash string) {
+	bs.SecretHash = hash
+}
+
+func (bs *ServiceBindingProjectionStatus) SummarizeWorkloads() *WorkloadsSummary {
+	if bs.Workloads == nil {
+		return nil
+	}
+	summary := &WorkloadsSummary{
+		Matched: int32(len(bs.Workloads)),
+	}
+	for _, w := range bs.Workloads {
+		if w.Injected {
+			summary.Injected++
+		} else {
+			summary.Failed = append(summary.Failed, w.Name)
+		}
+	}
+	return summary
+}
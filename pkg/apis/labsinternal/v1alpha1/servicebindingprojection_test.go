@@ -97,6 +97,76 @@ func TestServiceBindingProjection_Validate(t *testing.T) {
 			},
 			expected: nil,
 		},
+		{
+			name: "valid, services",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Services: []ProjectedService{
+						{
+							Name: "my-cache",
+							Type: "redis",
+							Binding: corev1.LocalObjectReference{
+								Name: "my-cache-secret",
+							},
+						},
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid services",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Services: []ProjectedService{
+						{
+							Name: "my-binding",
+							Binding: corev1.LocalObjectReference{
+								Name: "my-secret",
+							},
+						},
+						{
+							Name: "my/cache",
+						},
+						{
+							Name: "my/cache",
+							Binding: corev1.LocalObjectReference{
+								Name: "my-cache-secret",
+							},
+						},
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("my-binding", "spec.services[0].name"),
+				apis.ErrInvalidValue("my/cache", "spec.services[1].name"),
+				apis.ErrMissingField("spec.services[1].binding"),
+				apis.ErrInvalidValue("my/cache", "spec.services[2].name"),
+				apis.ErrMultipleOneOf("spec.services[1].name", "spec.services[2].name"),
+			),
+		},
 		{
 			name: "valid, workload selector",
 			seed: &ServiceBindingProjection{
@@ -216,6 +286,255 @@ func TestServiceBindingProjection_Validate(t *testing.T) {
 				),
 			),
 		},
+		{
+			name: "duplicate env after another name",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Env: []EnvVar{
+						{Name: "MY_OTHER_VAR", Key: "my-key0"},
+						{Name: "MY_VAR", Key: "my-key1"},
+						{Name: "MY_VAR", Key: "my-key2"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMultipleOneOf(
+					"spec.env[1].name",
+					"spec.env[2].name",
+				),
+			),
+		},
+		{
+			name: "valid, mappings",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri", From: "connection-string"},
+						{Key: "password", Drop: true},
+						{Key: "credentials", Template: "{{ .username }}:{{ .password }}"},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid mappings",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Mappings: []SecretMapping{
+						{},
+						{Key: "not/a/key", From: "not/a/key"},
+						{Key: "uri", Template: "{{ .host"},
+						{Key: "host", From: "hostname", Drop: true},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.mappings[0].key"),
+				apis.ErrMissingOneOf("spec.mappings[0].from", "spec.mappings[0].template", "spec.mappings[0].drop"),
+				apis.ErrInvalidValue("not/a/key", "spec.mappings[1].key"),
+				apis.ErrInvalidValue("not/a/key", "spec.mappings[1].from"),
+				apis.ErrInvalidValue("{{ .host", "spec.mappings[2].template"),
+				apis.ErrMultipleOneOf("spec.mappings[3].from", "spec.mappings[3].drop"),
+			),
+		},
+		{
+			name: "valid, config map",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-config-map",
+					},
+					BindingKind: "ConfigMap",
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid binding kind",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-service",
+					},
+					BindingKind: "Service",
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+				},
+			},
+			expected: apis.ErrInvalidValue("Service", "spec.bindingKind"),
+		},
+		{
+			name: "disallow mappings of config map",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-config-map",
+					},
+					BindingKind: "ConfigMap",
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
+				},
+			},
+			expected: apis.ErrDisallowedFields("spec.mappings"),
+		},
+		{
+			name: "duplicate mappings",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri", From: "connection-string"},
+						{Key: "uri", Drop: true},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMultipleOneOf(
+					"spec.mappings[0].key",
+					"spec.mappings[1].key",
+				),
+			),
+		},
+		{
+			name: "invalid mount path",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					MountPath: "etc/db",
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("etc/db", "spec.mountPath"),
+			),
+		},
+		{
+			name: "valid, container patterns",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+						Containers:                 []string{"app", "app-*"},
+						IncludeInitContainers:      []string{"init-?"},
+						IncludeEphemeralContainers: []string{"debug-[a-z]*"},
+						ExcludeContainers:          []string{"*-sidecar"},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid container patterns",
+			seed: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+						Containers:                 []string{"app", ""},
+						IncludeInitContainers:      []string{"init-["},
+						IncludeEphemeralContainers: []string{"debug-\\"},
+						ExcludeContainers:          []string{"[]"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.workload.containers[1]"),
+				apis.ErrInvalidArrayValue("init-[", "spec.workload.includeInitContainers", 0),
+				apis.ErrInvalidArrayValue("debug-\\", "spec.workload.includeEphemeralContainers", 0),
+				apis.ErrInvalidArrayValue("[]", "spec.workload.excludeContainers", 0),
+			),
+		},
 		{
 			name: "disallow status annotations",
 			seed: &ServiceBindingProjection{
@@ -356,18 +675,67 @@ func TestServiceBindingProjectionStatus_MarkBindingUnavailable(t *testing.T) {
 	}
 }
 
-func TestServiceBindingProjectionStatus_InitializeConditions(t *testing.T) {
-	tests := []struct {
-		name     string
-		seed     *ServiceBindingProjectionStatus
-		expected *ServiceBindingProjectionStatus
-	}{
-		{
-			name: "empty",
-			seed: &ServiceBindingProjectionStatus{},
-			expected: &ServiceBindingProjectionStatus{
-				Status: duckv1.Status{
-					Conditions: duckv1.Conditions{
+func TestServiceBindingProjectionStatus_MarkUnbinding(t *testing.T) {
+	expected := &ServiceBindingProjectionStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{
+				{
+					Type:     ServiceBindingProjectionConditionUnbound,
+					Status:   corev1.ConditionFalse,
+					Severity: apis.ConditionSeverityInfo,
+					Reason:   "UnbindFailed",
+					Message:  "a message",
+				},
+			},
+		},
+	}
+	actual := &ServiceBindingProjectionStatus{}
+	actual.MarkUnbinding("UnbindFailed", "a message")
+
+	if diff := cmp.Diff(expected, actual, cmpopts.IgnoreTypes(apis.VolatileTime{})); diff != "" {
+		t.Errorf("MarkUnbinding() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestServiceBindingProjectionStatus_MarkContainersNotMatched(t *testing.T) {
+	expected := &ServiceBindingProjectionStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{
+				{
+					Type:     ServiceBindingProjectionConditionContainersMatched,
+					Status:   corev1.ConditionFalse,
+					Severity: apis.ConditionSeverityInfo,
+					Reason:   "ContainerNotFound",
+					Message:  "a message",
+				},
+			},
+		},
+	}
+	actual := &ServiceBindingProjectionStatus{}
+	actual.MarkContainersNotMatched("ContainerNotFound", "a message")
+
+	if diff := cmp.Diff(expected, actual, cmpopts.IgnoreTypes(apis.VolatileTime{})); diff != "" {
+		t.Errorf("MarkContainersNotMatched() (-expected, +actual): %s", diff)
+	}
+
+	actual.ClearContainersMatched()
+	if diff := cmp.Diff(&ServiceBindingProjectionStatus{}, actual, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("ClearContainersMatched() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestServiceBindingProjectionStatus_InitializeConditions(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingProjectionStatus
+		expected *ServiceBindingProjectionStatus
+	}{
+		{
+			name: "empty",
+			seed: &ServiceBindingProjectionStatus{},
+			expected: &ServiceBindingProjectionStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
 						{
 							Type:   ServiceBindingProjectionConditionReady,
 							Status: corev1.ConditionUnknown,
@@ -472,6 +840,64 @@ func TestServiceBindingProjection_SetObservedGeneration(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestServiceBindingProjectionStatus_SetWorkloads(t *testing.T) {
+	seed := &ServiceBindingProjection{}
+	seed.Status.SetWorkloads([]WorkloadStatus{
+		{Name: "my-workload-2", Injected: true},
+		{Name: "my-workload-1", Injected: true},
+	})
+	expected := []WorkloadStatus{
+		{Name: "my-workload-1", Injected: true},
+		{Name: "my-workload-2", Injected: true},
+	}
+	actual := seed.Status.Workloads
+	assert.Equal(t, expected, actual)
+}
+
+func TestServiceBindingProjectionStatus_SummarizeWorkloads(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingProjectionStatus
+		expected *WorkloadsSummary
+	}{
+		{
+			name:     "empty",
+			seed:     &ServiceBindingProjectionStatus{},
+			expected: nil,
+		},
+		{
+			name: "none matched",
+			seed: &ServiceBindingProjectionStatus{
+				Workloads: []WorkloadStatus{},
+			},
+			expected: &WorkloadsSummary{},
+		},
+		{
+			name: "mixed",
+			seed: &ServiceBindingProjectionStatus{
+				Workloads: []WorkloadStatus{
+					{Name: "my-workload-1", ObservedGeneration: 1, Injected: true},
+					{Name: "my-workload-2", ObservedGeneration: 1, Error: "failed binding subject my-workload-2"},
+					{Name: "my-workload-3", ObservedGeneration: 1, Error: "failed binding subject my-workload-3"},
+				},
+			},
+			expected: &WorkloadsSummary{
+				Matched:  3,
+				Injected: 1,
+				Failed:   []string{"my-workload-2", "my-workload-3"},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.SummarizeWorkloads()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: SummarizeWorkloads() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
 func TestServiceBindingProjection_Undo(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -485,6 +911,121 @@ func TestServiceBindingProjection_Undo(t *testing.T) {
 			seed:     &duckv1.WithPod{},
 			expected: &duckv1.WithPod{},
 		},
+		{
+			name: "remove volumes of services no longer projected",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret,my-cache-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"internal.bindings.labs.vmware.com/projection-cedbfad28c8f47b6d714edffa1b1825ef53b09db-type": "redis",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+										{
+											Name:      "binding-cedbfad28c8f47b6d714edffa1b1825ef53b09db",
+											MountPath: "/bindings/my-cache",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "binding-cedbfad28c8f47b6d714edffa1b1825ef53b09db",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-cache-secret",
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-cedbfad28c8f47b6d714edffa1b1825ef53b09db-type']",
+																},
+																Path: "type",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									VolumeMounts: []corev1.VolumeMount{},
+									Env:          []corev1.EnvVar{},
+								},
+							},
+							Volumes: []corev1.Volume{},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "remove bound volumes",
 			binding: &ServiceBindingProjection{
@@ -735,6 +1276,75 @@ func TestServiceBindingProjection_Undo(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "remove injected config map",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+			},
+			seed: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "injected-config-map",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name: "PRESERVE",
+										},
+										{
+											Name: "INJECTED",
+											ValueFrom: &corev1.EnvVarSource{
+												ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "injected-config-map",
+													},
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "preserve"},
+										{Name: "injected"},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{Name: "preserve", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "other-config-map"}}}}}}},
+								{Name: "injected", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "injected-config-map"}}}}}}},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{Name: "PRESERVE"},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "preserve"},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{Name: "preserve", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "other-config-map"}}}}}}},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "remove injected environment variables, type and provider mapping",
 			binding: &ServiceBindingProjection{
@@ -1041,10 +1651,11 @@ func TestServiceBindingProjection_Undo(t *testing.T) {
 
 func TestServiceBindingProjection_Do(t *testing.T) {
 	tests := []struct {
-		name     string
-		binding  *ServiceBindingProjection
-		seed     *duckv1.WithPod
-		expected *duckv1.WithPod
+		name      string
+		mountRoot string
+		binding   *ServiceBindingProjection
+		seed      *duckv1.WithPod
+		expected  *duckv1.WithPod
 	}{
 		{
 			name: "inject volume into each container",
@@ -1265,7 +1876,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "inject volume into named container",
+			name: "inject volume for each service",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -1275,9 +1886,13 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
-					Workload: WorkloadReference{
-						Containers: []string{
-							"my-container",
+					Services: []ProjectedService{
+						{
+							Name: "my-cache",
+							Type: "redis",
+							Binding: corev1.LocalObjectReference{
+								Name: "my-cache-secret",
+							},
 						},
 					},
 				},
@@ -1286,13 +1901,8 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
 						Spec: corev1.PodSpec{
-							InitContainers: []corev1.Container{
-								{},
-								{Name: "my-container"},
-							},
 							Containers: []corev1.Container{
 								{},
-								{Name: "my-container"},
 							},
 						},
 					},
@@ -1301,13 +1911,134 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			expected: &duckv1.WithPod{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
-						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret,my-cache-secret",
 					},
 				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
-						Spec: corev1.PodSpec{
-							InitContainers: []corev1.Container{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"internal.bindings.labs.vmware.com/projection-cedbfad28c8f47b6d714edffa1b1825ef53b09db-type": "redis",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+										{
+											Name:      "binding-cedbfad28c8f47b6d714edffa1b1825ef53b09db",
+											MountPath: "/bindings/my-cache",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "binding-cedbfad28c8f47b6d714edffa1b1825ef53b09db",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-cache-secret",
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-cedbfad28c8f47b6d714edffa1b1825ef53b09db-type']",
+																},
+																Path: "type",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "inject volume into named container",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Containers: []string{
+							"my-container",
+						},
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{},
+								{Name: "my-container"},
+							},
+							Containers: []corev1.Container{
+								{},
+								{Name: "my-container"},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
 								{},
 								{
 									Name: "my-container",
@@ -1369,7 +2100,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "preserve volume mounts",
+			name: "inject volume into containers matching patterns",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -1379,21 +2110,30 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
+					Workload: WorkloadReference{
+						Containers:                 []string{"app-*"},
+						IncludeInitContainers:      []string{"init-db"},
+						IncludeEphemeralContainers: []string{"debug-*"},
+						ExcludeContainers:          []string{"*-sidecar"},
+					},
 				},
 			},
 			seed: &duckv1.WithPod{
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
 						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{Name: "init-db"},
+								{Name: "app-migrate"},
+							},
 							Containers: []corev1.Container{
-								{
-									VolumeMounts: []corev1.VolumeMount{
-										{Name: "preserve"},
-									},
-								},
+								{Name: "app-web"},
+								{Name: "app-sidecar"},
+								{Name: "other"},
 							},
-							Volumes: []corev1.Volume{
-								{Name: "preserve"},
+							EphemeralContainers: []corev1.EphemeralContainer{
+								{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debug-shell"}},
+								{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "shell"}},
 							},
 						},
 					},
@@ -1408,8 +2148,28 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
 						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{
+									Name: "init-db",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+								{Name: "app-migrate"},
+							},
 							Containers: []corev1.Container{
 								{
+									Name: "app-web",
 									Env: []corev1.EnvVar{
 										{
 											Name:  "SERVICE_BINDING_ROOT",
@@ -1417,7 +2177,6 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
-										{Name: "preserve"},
 										{
 											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
 											MountPath: "/bindings/my-binding-name",
@@ -1425,9 +2184,31 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 										},
 									},
 								},
+								{Name: "app-sidecar"},
+								{Name: "other"},
+							},
+							EphemeralContainers: []corev1.EphemeralContainer{
+								{
+									EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+										Name: "debug-shell",
+										Env: []corev1.EnvVar{
+											{
+												Name:  "SERVICE_BINDING_ROOT",
+												Value: "/bindings",
+											},
+										},
+										VolumeMounts: []corev1.VolumeMount{
+											{
+												Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+												MountPath: "/bindings/my-binding-name",
+												ReadOnly:  true,
+											},
+										},
+									},
+								},
+								{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "shell"}},
 							},
 							Volumes: []corev1.Volume{
-								{Name: "preserve"},
 								{
 									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
 									VolumeSource: corev1.VolumeSource{
@@ -1451,7 +2232,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "inject volume at custom path",
+			name: "preserve volume mounts",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -1469,14 +2250,14 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
-									Env: []corev1.EnvVar{
-										{
-											Name:  "SERVICE_BINDING_ROOT",
-											Value: "/custom/path",
-										},
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "preserve"},
 									},
 								},
 							},
+							Volumes: []corev1.Volume{
+								{Name: "preserve"},
+							},
 						},
 					},
 				},
@@ -1495,19 +2276,21 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 									Env: []corev1.EnvVar{
 										{
 											Name:  "SERVICE_BINDING_ROOT",
-											Value: "/custom/path",
+											Value: "/bindings",
 										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
+										{Name: "preserve"},
 										{
 											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
-											MountPath: "/custom/path/my-binding-name",
+											MountPath: "/bindings/my-binding-name",
 											ReadOnly:  true,
 										},
 									},
 								},
 							},
 							Volumes: []corev1.Volume{
+								{Name: "preserve"},
 								{
 									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
 									VolumeSource: corev1.VolumeSource{
@@ -1531,7 +2314,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "inject envvars",
+			name: "inject volume at custom path",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -1541,12 +2324,6 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
-					Env: []EnvVar{
-						{
-							Name: "MY_VAR",
-							Key:  "my-key",
-						},
-					},
 				},
 			},
 			seed: &duckv1.WithPod{
@@ -1557,7 +2334,8 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 								{
 									Env: []corev1.EnvVar{
 										{
-											Name: "PRESERVE",
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/custom/path",
 										},
 									},
 								},
@@ -1578,29 +2356,15 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
-										{
-											Name: "PRESERVE",
-										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
-											Value: "/bindings",
-										},
-										{
-											Name: "MY_VAR",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: "my-secret",
-													},
-													Key: "my-key",
-												},
-											},
+											Value: "/custom/path",
 										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
 											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
-											MountPath: "/bindings/my-binding-name",
+											MountPath: "/custom/path/my-binding-name",
 											ReadOnly:  true,
 										},
 									},
@@ -1630,15 +2394,13 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "inject envvars, with overridden type and provider",
+			name: "inject envvars",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
 				},
 				Spec: ServiceBindingProjectionSpec{
-					Name:     "my-binding-name",
-					Type:     "my-type",
-					Provider: "my-provider",
+					Name: "my-binding-name",
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
@@ -1647,14 +2409,6 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 							Name: "MY_VAR",
 							Key:  "my-key",
 						},
-						{
-							Name: "MY_TYPE",
-							Key:  "type",
-						},
-						{
-							Name: "MY_PROVIDER",
-							Key:  "provider",
-						},
 					},
 				},
 			},
@@ -1683,12 +2437,6 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
-						ObjectMeta: metav1.ObjectMeta{
-							Annotations: map[string]string{
-								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-type":     "my-type",
-								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-provider": "my-provider",
-							},
-						},
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
@@ -1700,22 +2448,6 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "MY_PROVIDER",
-											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-provider']",
-												},
-											},
-										},
-										{
-											Name: "MY_TYPE",
-											ValueFrom: &corev1.EnvVarSource{
-												FieldRef: &corev1.ObjectFieldSelector{
-													FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-type']",
-												},
-											},
-										},
 										{
 											Name: "MY_VAR",
 											ValueFrom: &corev1.EnvVarSource{
@@ -1750,30 +2482,6 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 														},
 													},
 												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-type']",
-																},
-																Path: "type",
-															},
-														},
-													},
-												},
-												{
-													DownwardAPI: &corev1.DownwardAPIProjection{
-														Items: []corev1.DownwardAPIVolumeFile{
-															{
-																FieldRef: &corev1.ObjectFieldSelector{
-																	FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-provider']",
-																},
-																Path: "provider",
-															},
-														},
-													},
-												},
 											},
 										},
 									},
@@ -1785,7 +2493,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "runs undo before do",
+			name: "inject config map",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -1793,22 +2501,23 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 				Spec: ServiceBindingProjectionSpec{
 					Name: "my-binding-name",
 					Binding: corev1.LocalObjectReference{
-						Name: "my-secret",
+						Name: "my-config-map",
+					},
+					BindingKind: "ConfigMap",
+					Env: []EnvVar{
+						{
+							Name: "MY_VAR",
+							Key:  "my-key",
+						},
 					},
 				},
 			},
 			seed: &duckv1.WithPod{
-				ObjectMeta: metav1.ObjectMeta{
-					Annotations: map[string]string{
-						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
-					},
-				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
 						Spec: corev1.PodSpec{
-							Volumes: []corev1.Volume{
-								{Name: "preserve"},
-								{Name: "injected", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}}}}}}},
+							Containers: []corev1.Container{
+								{},
 							},
 						},
 					},
@@ -1817,23 +2526,50 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			expected: &duckv1.WithPod{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
-						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-config-map",
 					},
 				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
 						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "MY_VAR",
+											ValueFrom: &corev1.EnvVarSource{
+												ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "my-config-map",
+													},
+													Key: "my-key",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-f6d8ba6acbc9f57fcf56115e40a9ac3a0c8b93b4",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
 							Volumes: []corev1.Volume{
-								{Name: "preserve"},
 								{
-									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									Name: "binding-f6d8ba6acbc9f57fcf56115e40a9ac3a0c8b93b4",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
-													Secret: &corev1.SecretProjection{
+													ConfigMap: &corev1.ConfigMapProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "my-secret",
+															Name: "my-config-map",
 														},
 													},
 												},
@@ -1848,7 +2584,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "idempotent",
+			name: "inject mapped secret",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -1858,45 +2594,59 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
+					Env: []EnvVar{
+						{
+							Name: "MY_VAR",
+							Key:  "uri",
+						},
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
 				},
 			},
 			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
-						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-binding-mapped",
 					},
 				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
 						Spec: corev1.PodSpec{
-							InitContainers: []corev1.Container{
+							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-									},
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
-											MountPath: "/bindings/my-binding-name",
-											ReadOnly:  true,
-										},
-									},
-								},
-							},
-							Containers: []corev1.Container{
-								{
-									Env: []corev1.EnvVar{
 										{
-											Name:  "SERVICE_BINDING_ROOT",
-											Value: "/bindings",
+											Name: "MY_VAR",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "my-binding-mapped",
+													},
+													Key: "uri",
+												},
+											},
 										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
-											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											Name:      "binding-09c9f900d4b33922bcb64b736eff0d1846847fb7",
 											MountPath: "/bindings/my-binding-name",
 											ReadOnly:  true,
 										},
@@ -1905,14 +2655,14 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 							},
 							Volumes: []corev1.Volume{
 								{
-									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									Name: "binding-09c9f900d4b33922bcb64b736eff0d1846847fb7",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "my-secret",
+															Name: "my-binding-mapped",
 														},
 													},
 												},
@@ -1925,6 +2675,40 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					},
 				},
 			},
+		},
+		{
+			name: "inject secret hash",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					RolloutOnSecretChange: true,
+				},
+				Status: ServiceBindingProjectionStatus{
+					SecretHash: "my-hash",
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-secret-hash": "my-previous-hash",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
 			expected: &duckv1.WithPod{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
@@ -1933,24 +2717,12 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
-						Spec: corev1.PodSpec{
-							InitContainers: []corev1.Container{
-								{
-									Env: []corev1.EnvVar{
-										{
-											Name:  "SERVICE_BINDING_ROOT",
-											Value: "/bindings",
-										},
-									},
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
-											MountPath: "/bindings/my-binding-name",
-											ReadOnly:  true,
-										},
-									},
-								},
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-secret-hash": "my-hash",
 							},
+						},
+						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
@@ -1992,7 +2764,7 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 			},
 		},
 		{
-			name: "deterministic sorting of injected values with multiple bindings",
+			name: "secret hash not injected without opt in",
 			binding: &ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "my-binding",
@@ -2002,18 +2774,31 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
-					Env: []EnvVar{
-						{
-							Name: "MY_VAR",
-							Key:  "my-key",
+				},
+				Status: ServiceBindingProjectionStatus{
+					SecretHash: "my-hash",
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-secret-hash": "my-previous-hash",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
 						},
 					},
 				},
 			},
-			seed: &duckv1.WithPod{
+			expected: &duckv1.WithPod{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
-						"internal.bindings.labs.vmware.com/projection-6ef41bce7c986a8c7fc75d96ebdbed612ff3ef24": "other-secret",
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
 					},
 				},
 				Spec: duckv1.WithPodSpec{
@@ -2022,29 +2807,15 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 							Containers: []corev1.Container{
 								{
 									Env: []corev1.EnvVar{
-										{
-											Name: "PRESERVE",
-										},
 										{
 											Name:  "SERVICE_BINDING_ROOT",
 											Value: "/bindings",
 										},
-										{
-											Name: "OTHER_VAR",
-											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: "other-secret",
-													},
-													Key: "my-key",
-												},
-											},
-										},
 									},
 									VolumeMounts: []corev1.VolumeMount{
 										{
-											Name:      "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
-											MountPath: "/bindings/other-binding-name",
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
 											ReadOnly:  true,
 										},
 									},
@@ -2052,14 +2823,14 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 							},
 							Volumes: []corev1.Volume{
 								{
-									Name: "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "other-secret",
+															Name: "my-secret",
 														},
 													},
 												},
@@ -2072,15 +2843,67 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 					},
 				},
 			},
+		},
+		{
+			name: "inject envvars, with overridden type and provider",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name:     "my-binding-name",
+					Type:     "my-type",
+					Provider: "my-provider",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Env: []EnvVar{
+						{
+							Name: "MY_VAR",
+							Key:  "my-key",
+						},
+						{
+							Name: "MY_TYPE",
+							Key:  "type",
+						},
+						{
+							Name: "MY_PROVIDER",
+							Key:  "provider",
+						},
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name: "PRESERVE",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			expected: &duckv1.WithPod{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
-						"internal.bindings.labs.vmware.com/projection-6ef41bce7c986a8c7fc75d96ebdbed612ff3ef24": "other-secret",
 						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
 					},
 				},
 				Spec: duckv1.WithPodSpec{
 					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-type":     "my-type",
+								"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-provider": "my-provider",
+							},
+						},
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
@@ -2093,22 +2916,27 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 											Value: "/bindings",
 										},
 										{
-											Name: "MY_VAR",
+											Name: "MY_PROVIDER",
 											ValueFrom: &corev1.EnvVarSource{
-												SecretKeyRef: &corev1.SecretKeySelector{
-													LocalObjectReference: corev1.LocalObjectReference{
-														Name: "my-secret",
-													},
-													Key: "my-key",
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-provider']",
 												},
 											},
 										},
 										{
-											Name: "OTHER_VAR",
+											Name: "MY_TYPE",
+											ValueFrom: &corev1.EnvVarSource{
+												FieldRef: &corev1.ObjectFieldSelector{
+													FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-type']",
+												},
+											},
+										},
+										{
+											Name: "MY_VAR",
 											ValueFrom: &corev1.EnvVarSource{
 												SecretKeyRef: &corev1.SecretKeySelector{
 													LocalObjectReference: corev1.LocalObjectReference{
-														Name: "other-secret",
+														Name: "my-secret",
 													},
 													Key: "my-key",
 												},
@@ -2121,9 +2949,170 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 											MountPath: "/bindings/my-binding-name",
 											ReadOnly:  true,
 										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-type']",
+																},
+																Path: "type",
+															},
+														},
+													},
+												},
+												{
+													DownwardAPI: &corev1.DownwardAPIProjection{
+														Items: []corev1.DownwardAPIVolumeFile{
+															{
+																FieldRef: &corev1.ObjectFieldSelector{
+																	FieldPath: "metadata.annotations['internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17-provider']",
+																},
+																Path: "provider",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "runs undo before do",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{Name: "preserve"},
+								{Name: "injected", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}}}}}}},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Volumes: []corev1.Volume{
+								{Name: "preserve"},
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "idempotent",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
 										{
-											Name:      "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
-											MountPath: "/bindings/other-binding-name",
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
 											ReadOnly:  true,
 										},
 									},
@@ -2146,15 +3135,64 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 										},
 									},
 								},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Containers: []corev1.Container{
 								{
-									Name: "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
 									VolumeSource: corev1.VolumeSource{
 										Projected: &corev1.ProjectedVolumeSource{
 											Sources: []corev1.VolumeProjection{
 												{
 													Secret: &corev1.SecretProjection{
 														LocalObjectReference: corev1.LocalObjectReference{
-															Name: "other-secret",
+															Name: "my-secret",
 														},
 													},
 												},
@@ -2168,17 +3206,802 @@ func TestServiceBindingProjection_Do(t *testing.T) {
 				},
 			},
 		},
-	}
-	for _, c := range tests {
-		t.Run(c.name, func(t *testing.T) {
-			actual := c.seed.DeepCopy()
-			binding := c.binding.DeepCopy()
-			binding.Do(context.TODO(), actual)
-			if diff := cmp.Diff(c.binding, binding); diff != "" {
-				t.Errorf("%s: Do() unexpected binding mutation (-expected, +actual): %s", c.name, diff)
-			}
-			if diff := cmp.Diff(c.expected, actual, cmpopts.EquateEmpty()); diff != "" {
-				t.Errorf("%s: Do() (-expected, +actual): %s", c.name, diff)
+		{
+			name: "deterministic sorting of injected values with multiple bindings",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Env: []EnvVar{
+						{
+							Name: "MY_VAR",
+							Key:  "my-key",
+						},
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-6ef41bce7c986a8c7fc75d96ebdbed612ff3ef24": "other-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name: "PRESERVE",
+										},
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "OTHER_VAR",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "other-secret",
+													},
+													Key: "my-key",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
+											MountPath: "/bindings/other-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "other-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-6ef41bce7c986a8c7fc75d96ebdbed612ff3ef24": "other-secret",
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name: "PRESERVE",
+										},
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+										{
+											Name: "MY_VAR",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "my-secret",
+													},
+													Key: "my-key",
+												},
+											},
+										},
+										{
+											Name: "OTHER_VAR",
+											ValueFrom: &corev1.EnvVarSource{
+												SecretKeyRef: &corev1.SecretKeySelector{
+													LocalObjectReference: corev1.LocalObjectReference{
+														Name: "other-secret",
+													},
+													Key: "my-key",
+												},
+											},
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+										{
+											Name:      "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
+											MountPath: "/bindings/other-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								{
+									Name: "binding-a9a23274b0590d5057aae1ae621be723716c4dd5",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "other-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "inject volume under the mount root from the context",
+			mountRoot: "/platform/bindings",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/platform/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/platform/bindings/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "container mount root takes precedence over the context",
+			mountRoot: "/platform/bindings",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/custom",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/custom",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/custom/my-binding-name",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "inject volume at the binding mount path",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					MountPath: "/etc/db",
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/etc/db",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "skip volume mount colliding with an existing mount",
+			binding: &ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					MountPath: "/etc/db",
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "config",
+											MountPath: "/etc/db/",
+										},
+									},
+								},
+								{
+									Name: "sidecar",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &duckv1.WithPod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-16384e6a11df69776193b6a877bfbe80bab09a17": "my-secret",
+					},
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "config",
+											MountPath: "/etc/db/",
+										},
+									},
+								},
+								{
+									Name: "sidecar",
+									Env: []corev1.EnvVar{
+										{
+											Name:  "SERVICE_BINDING_ROOT",
+											Value: "/bindings",
+										},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{
+											Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											MountPath: "/etc/db",
+											ReadOnly:  true,
+										},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+									VolumeSource: corev1.VolumeSource{
+										Projected: &corev1.ProjectedVolumeSource{
+											Sources: []corev1.VolumeProjection{
+												{
+													Secret: &corev1.SecretProjection{
+														LocalObjectReference: corev1.LocalObjectReference{
+															Name: "my-secret",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if c.mountRoot != "" {
+				ctx = WithMountRoot(ctx, c.mountRoot)
+			}
+			actual := c.seed.DeepCopy()
+			binding := c.binding.DeepCopy()
+			binding.Do(ctx, actual)
+			if diff := cmp.Diff(c.binding, binding); diff != "" {
+				t.Errorf("%s: Do() unexpected binding mutation (-expected, +actual): %s", c.name, diff)
+			}
+			if diff := cmp.Diff(c.expected, actual, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("%s: Do() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingProjection_MountPathCollisions(t *testing.T) {
+	tests := []struct {
+		name      string
+		mountRoot string
+		binding   *ServiceBindingProjection
+		seed      *duckv1.WithPod
+		expected  []string
+	}{
+		{
+			name: "no collisions",
+			binding: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b", MountPath: "/bindings/my-binding-name"},
+										{Name: "config", MountPath: "/etc/config"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "collisions",
+			binding: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					MountPath: "/etc/db",
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{
+								{
+									Name: "init",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "config", MountPath: "/etc/db/"},
+									},
+								},
+							},
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "config", MountPath: "/etc/db"},
+									},
+								},
+								{
+									Name: "sidecar",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b", MountPath: "/etc/db"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"init", "app"},
+		},
+		{
+			name: "ignore containers that are not targeted",
+			binding: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					Workload: WorkloadReference{
+						Containers: []string{"sidecar"},
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "config", MountPath: "/bindings/my-binding-name"},
+									},
+								},
+								{
+									Name: "sidecar",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "mount root from the container and context",
+			mountRoot: "/platform/bindings",
+			binding: &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Name: "my-binding-name",
+					Binding: corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+				},
+			},
+			seed: &duckv1.WithPod{
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "config", MountPath: "/platform/bindings/my-binding-name"},
+									},
+								},
+								{
+									Name: "custom",
+									Env: []corev1.EnvVar{
+										{Name: "SERVICE_BINDING_ROOT", Value: "/custom"},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "config", MountPath: "/platform/bindings/my-binding-name"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"app"},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+			if c.mountRoot != "" {
+				ctx = WithMountRoot(ctx, c.mountRoot)
+			}
+			actual := c.binding.MountPathCollisions(ctx, c.seed)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: MountPathCollisions() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingProjection_InjectedContainers(t *testing.T) {
+	binding := &ServiceBindingProjection{
+		Spec: ServiceBindingProjectionSpec{
+			Name: "my-binding-name",
+			Binding: corev1.LocalObjectReference{
+				Name: "my-secret",
+			},
+			Workload: WorkloadReference{
+				Containers: []string{"init", "app", "typo"},
+			},
+		},
+	}
+	ps := &duckv1.WithPod{
+		Spec: duckv1.WithPodSpec{
+			Template: duckv1.PodSpecable{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name: "init",
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b", MountPath: "/bindings/my-binding-name"},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "config", MountPath: "/etc/config"},
+								{Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b", MountPath: "/bindings/my-binding-name"},
+							},
+						},
+						{
+							Name: "sidecar",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff([]string{"init", "app"}, binding.InjectedContainers(ps)); diff != "" {
+		t.Errorf("InjectedContainers() (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff([]string{"typo"}, binding.MissingContainers(ps)); diff != "" {
+		t.Errorf("MissingContainers() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestServiceBindingProjection_MissingContainers(t *testing.T) {
+	ps := &duckv1.WithPod{
+		Spec: duckv1.WithPodSpec{
+			Template: duckv1.PodSpecable{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{Name: "init-db"},
+					},
+					Containers: []corev1.Container{
+						{Name: "app-web"},
+					},
+					EphemeralContainers: []corev1.EphemeralContainer{
+						{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debug-shell"}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		workload WorkloadReference
+		expected []string
+	}{
+		{
+			name:     "all containers",
+			workload: WorkloadReference{},
+		},
+		{
+			name: "matched",
+			workload: WorkloadReference{
+				Containers:                 []string{"app-*", "init-db"},
+				IncludeInitContainers:      []string{"init-*"},
+				IncludeEphemeralContainers: []string{"debug-shell"},
+			},
+		},
+		{
+			name: "not matched",
+			workload: WorkloadReference{
+				Containers:                 []string{"web-*", "debug-shell"},
+				IncludeInitContainers:      []string{"app-web"},
+				IncludeEphemeralContainers: []string{"shell"},
+			},
+			expected: []string{"web-*", "debug-shell", "app-web", "shell"},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			binding := &ServiceBindingProjection{
+				Spec: ServiceBindingProjectionSpec{
+					Workload: c.workload,
+				},
+			}
+			if diff := cmp.Diff(c.expected, binding.MissingContainers(ps)); diff != "" {
+				t.Errorf("MissingContainers() (-expected, +actual): %s", diff)
 			}
 		})
 	}
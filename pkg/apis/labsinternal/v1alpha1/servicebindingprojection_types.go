@@ -8,10 +8,13 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"path"
+	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/apis/duck"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -22,6 +25,12 @@ import (
 
 const (
 	ServiceBindingProjectionAnnotationKey = GroupName + "/projection"
+
+	// BindingKindSecret projects the binding from a Secret
+	BindingKindSecret = "Secret"
+	// BindingKindConfigMap projects the binding from a ConfigMap, for
+	// services exposing only non-sensitive binding data
+	BindingKindConfigMap = "ConfigMap"
 )
 
 // +genclient
@@ -61,6 +70,15 @@ type ServiceBindingProjectionSpec struct {
 
 	// Binding reference to the service binding's projected secret
 	Binding corev1.LocalObjectReference `json:"binding"`
+	// BindingKind is the kind of the resource referenced by Binding, either
+	// Secret or ConfigMap. Defaults to Secret
+	// +optional
+	BindingKind string `json:"bindingKind,omitempty"`
+
+	// Services are projected alongside the binding, each as a binding of
+	// its own named after the service
+	// +optional
+	Services []ProjectedService `json:"services,omitempty"`
 
 	// Workload resource to inject the binding into
 	Workload WorkloadReference `json:"workload"`
@@ -68,14 +86,65 @@ type ServiceBindingProjectionSpec struct {
 	// Env projects keys from the binding secret into the workload as
 	// environment variables
 	Env []EnvVar `json:"env,omitempty"`
+
+	// Mappings rename, drop or derive entries of the binding secret. When
+	// set, a mapped copy of the binding secret is projected into the
+	// workload instead of the binding secret
+	// +optional
+	Mappings []SecretMapping `json:"mappings,omitempty"`
+
+	// RolloutOnSecretChange annotates the workload's pod template with a hash
+	// of the projected secret, so that the workload is rolled out when the
+	// contents of the secret change
+	// +optional
+	RolloutOnSecretChange bool `json:"rolloutOnSecretChange,omitempty"`
+
+	// MountPath is the absolute path the binding is mounted at in the
+	// workload's containers, instead of `$SERVICE_BINDING_ROOT/<name>`
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+type ProjectedService struct {
+	// Name of the service's binding on disk
+	Name string `json:"name"`
+	// Type of the service. The value is exposed directly as the `type` in
+	// the service's mounted binding
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Provider of the service. The value is exposed directly as the
+	// `provider` in the service's mounted binding
+	// +optional
+	Provider string `json:"provider,omitempty"`
+	// Binding reference to the service's binding secret
+	Binding corev1.LocalObjectReference `json:"binding"`
 }
 
 type WorkloadReference struct {
 	tracker.Reference
 
-	// Containers to target within the workload. If not set, all containers
-	// will be injected.
+	// Containers to target within the workload, by name or glob pattern,
+	// e.g. `app-*`. If not set, all containers will be injected. Init
+	// containers are also matched, unless IncludeInitContainers is set.
 	Containers []string `json:"containers,omitempty"`
+
+	// IncludeInitContainers are the init containers to target within the
+	// workload, by name or glob pattern, instead of those matched by
+	// Containers
+	// +optional
+	IncludeInitContainers []string `json:"includeInitContainers,omitempty"`
+
+	// IncludeEphemeralContainers are the ephemeral containers to target
+	// within the workload, by name or glob pattern. Ephemeral containers are
+	// not injected otherwise.
+	// +optional
+	IncludeEphemeralContainers []string `json:"includeEphemeralContainers,omitempty"`
+
+	// ExcludeContainers are the containers, init containers and ephemeral
+	// containers never injected, by name or glob pattern, even when matched
+	// by another list
+	// +optional
+	ExcludeContainers []string `json:"excludeContainers,omitempty"`
 }
 
 type EnvVar struct {
@@ -83,8 +152,72 @@ type EnvVar struct {
 	Key  string `json:"key"`
 }
 
+type SecretMapping struct {
+	// Key of the entry in the projected binding
+	Key string `json:"key"`
+	// From is the key of an entry in the binding secret to rename to Key
+	// +optional
+	From string `json:"from,omitempty"`
+	// Template derives the value of Key from the entries of the binding
+	// secret, e.g. `postgres://{{ .username }}:{{ .password }}@{{ .host }}`
+	// +optional
+	Template string `json:"template,omitempty"`
+	// Drop removes the entry for Key from the projected binding
+	// +optional
+	Drop bool `json:"drop,omitempty"`
+}
+
 type ServiceBindingProjectionStatus struct {
 	duckv1.Status `json:",inline"`
+
+	// Workloads is the binding state of each workload resource matched by
+	// the workload reference, sorted by name
+	// +optional
+	Workloads []WorkloadStatus `json:"workloads,omitempty"`
+
+	// SecretHash is the hash of the projected secret's data last applied to
+	// the workloads, when RolloutOnSecretChange is set
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+type WorkloadStatus struct {
+	// Name of the workload resource
+	Name string `json:"name"`
+	// ObservedGeneration is the 'Generation' of the workload resource that
+	// was last bound by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Injected is true when the binding is projected into the workload
+	Injected bool `json:"injected"`
+	// AdmissionOnly is true when the workload is only bound when it is
+	// created, like a Pod whose spec is immutable. Changes to the binding are
+	// not applied to the workload until it is recreated
+	// +optional
+	AdmissionOnly bool `json:"admissionOnly,omitempty"`
+	// Containers is the names of the init containers, containers and
+	// ephemeral containers of the workload the binding is projected into
+	// +optional
+	Containers []string `json:"containers,omitempty"`
+	// Error describes why the binding could not be projected into the
+	// workload
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// WorkloadsSummary aggregates the binding state of the workload resources
+// matched by a binding
+type WorkloadsSummary struct {
+	// Matched is the number of workload resources matched by the workload
+	// reference
+	Matched int32 `json:"matched"`
+	// Injected is the number of matched workload resources the binding is
+	// projected into
+	Injected int32 `json:"injected"`
+	// Failed is the names of matched workload resources the binding could
+	// not be projected into
+	// +optional
+	Failed []string `json:"failed,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -107,6 +240,47 @@ func (b *ServiceBindingProjection) Validate(ctx context.Context) (errs *apis.Fie
 			apis.ErrMissingField("spec.binding"),
 		)
 	}
+	switch b.Spec.BindingKind {
+	case "", BindingKindSecret:
+	case BindingKindConfigMap:
+		if len(b.Spec.Mappings) != 0 {
+			// the mapped copy of the binding is a Secret
+			errs = errs.Also(
+				apis.ErrDisallowedFields("spec.mappings"),
+			)
+		}
+	default:
+		errs = errs.Also(
+			apis.ErrInvalidValue(b.Spec.BindingKind, "spec.bindingKind"),
+		)
+	}
+
+	serviceSet := map[string][]int{}
+	for i, s := range b.Spec.Services {
+		errs = errs.Also(
+			s.Validate(ctx).ViaFieldIndex("services", i).ViaField("spec"),
+		)
+		serviceSet[s.Name] = append(serviceSet[s.Name], i)
+	}
+	// look for conflicting names, including the name of the binding
+	for name, v := range serviceSet {
+		if name == b.Spec.Name {
+			for _, i := range v {
+				errs = errs.Also(
+					apis.ErrInvalidValue(name, fmt.Sprintf("spec.services[%d].name", i)),
+				)
+			}
+		}
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.services[%d].name", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
 
 	a := b.Spec.Workload.DeepCopy()
 	a.Namespace = "fake"
@@ -134,7 +308,27 @@ func (b *ServiceBindingProjection) Validate(ctx context.Context) (errs *apis.Fie
 		if len(v) != 1 {
 			paths := make([]string, len(v))
 			for pi, i := range v {
-				paths[i] = fmt.Sprintf("spec.env[%d].name", pi)
+				paths[pi] = fmt.Sprintf("spec.env[%d].name", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
+
+	mappingSet := map[string][]int{}
+	for i, m := range b.Spec.Mappings {
+		errs = errs.Also(
+			m.Validate(ctx).ViaFieldIndex("mappings", i).ViaField("spec"),
+		)
+		mappingSet[m.Key] = append(mappingSet[m.Key], i)
+	}
+	// look for conflicting keys
+	for _, v := range mappingSet {
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.mappings[%d].key", i)
 			}
 			errs = errs.Also(
 				apis.ErrMultipleOneOf(paths...),
@@ -142,6 +336,12 @@ func (b *ServiceBindingProjection) Validate(ctx context.Context) (errs *apis.Fie
 		}
 	}
 
+	if b.Spec.MountPath != "" && !path.IsAbs(b.Spec.MountPath) {
+		errs = errs.Also(
+			apis.ErrInvalidValue(b.Spec.MountPath, "spec.mountPath"),
+		)
+	}
+
 	if b.Status.Annotations != nil {
 		errs = errs.Also(
 			apis.ErrDisallowedFields("status.annotations"),
@@ -151,6 +351,55 @@ func (b *ServiceBindingProjection) Validate(ctx context.Context) (errs *apis.Fie
 	return errs
 }
 
+func (w *WorkloadReference) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(w.Reference.Validate(ctx))
+	errs = errs.Also(
+		validateContainerPatterns(w.Containers, "containers"),
+		validateContainerPatterns(w.IncludeInitContainers, "includeInitContainers"),
+		validateContainerPatterns(w.IncludeEphemeralContainers, "includeEphemeralContainers"),
+		validateContainerPatterns(w.ExcludeContainers, "excludeContainers"),
+	)
+
+	return errs
+}
+
+// validateContainerPatterns checks each container name or glob pattern is a
+// well formed pattern.
+func validateContainerPatterns(patterns []string, field string) (errs *apis.FieldError) {
+	for i, p := range patterns {
+		if p == "" {
+			errs = errs.Also(
+				apis.ErrMissingField(apis.CurrentField).ViaFieldIndex(field, i),
+			)
+		} else if _, err := path.Match(p, ""); err != nil {
+			errs = errs.Also(
+				apis.ErrInvalidArrayValue(p, field, i),
+			)
+		}
+	}
+
+	return errs
+}
+
+func (s ProjectedService) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if s.Name == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("name"),
+		)
+	} else if msgs := validation.IsConfigMapKey(s.Name); len(msgs) != 0 {
+		errs = errs.Also(
+			apis.ErrInvalidValue(s.Name, "name"),
+		)
+	}
+	if s.Binding.Name == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("binding"),
+		)
+	}
+
+	return errs
+}
+
 func (e EnvVar) Validate(ctx context.Context) (errs *apis.FieldError) {
 	if e.Name == "" {
 		errs = errs.Also(
@@ -166,6 +415,58 @@ func (e EnvVar) Validate(ctx context.Context) (errs *apis.FieldError) {
 	return errs
 }
 
+func (m SecretMapping) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if m.Key == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("key"),
+		)
+	} else if msgs := validation.IsConfigMapKey(m.Key); len(msgs) != 0 {
+		errs = errs.Also(
+			apis.ErrInvalidValue(m.Key, "key"),
+		)
+	}
+
+	set := []string{}
+	if m.From != "" {
+		set = append(set, "from")
+		if msgs := validation.IsConfigMapKey(m.From); len(msgs) != 0 {
+			errs = errs.Also(
+				apis.ErrInvalidValue(m.From, "from"),
+			)
+		}
+	}
+	if m.Template != "" {
+		set = append(set, "template")
+		if _, err := m.ParseTemplate(); err != nil {
+			errs = errs.Also(
+				apis.ErrInvalidValue(m.Template, "template"),
+			)
+		}
+	}
+	if m.Drop {
+		set = append(set, "drop")
+	}
+	switch len(set) {
+	case 0:
+		errs = errs.Also(
+			apis.ErrMissingOneOf("from", "template", "drop"),
+		)
+	case 1:
+	default:
+		errs = errs.Also(
+			apis.ErrMultipleOneOf(set...),
+		)
+	}
+
+	return errs
+}
+
+// ParseTemplate parses the mapping's template. Referencing an entry that is
+// missing from the binding secret is an error when the template is executed.
+func (m SecretMapping) ParseTemplate() (*template.Template, error) {
+	return template.New(m.Key).Option("missingkey=error").Parse(m.Template)
+}
+
 func (b *ServiceBindingProjection) SetDefaults(context.Context) {
 	// no defaults to apply
 }
@@ -30,6 +30,39 @@ func (in *EnvVar) DeepCopy() *EnvVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectedService) DeepCopyInto(out *ProjectedService) {
+	*out = *in
+	out.Binding = in.Binding
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectedService.
+func (in *ProjectedService) DeepCopy() *ProjectedService {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectedService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMapping) DeepCopyInto(out *SecretMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMapping.
+func (in *SecretMapping) DeepCopy() *SecretMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingProjection) DeepCopyInto(out *ServiceBindingProjection) {
 	*out = *in
@@ -95,12 +128,22 @@ func (in *ServiceBindingProjectionList) DeepCopyObject() runtime.Object {
 func (in *ServiceBindingProjectionSpec) DeepCopyInto(out *ServiceBindingProjectionSpec) {
 	*out = *in
 	out.Binding = in.Binding
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ProjectedService, len(*in))
+		copy(*out, *in)
+	}
 	in.Workload.DeepCopyInto(&out.Workload)
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]EnvVar, len(*in))
 		copy(*out, *in)
 	}
+	if in.Mappings != nil {
+		in, out := &in.Mappings, &out.Mappings
+		*out = make([]SecretMapping, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -118,6 +161,13 @@ func (in *ServiceBindingProjectionSpec) DeepCopy() *ServiceBindingProjectionSpec
 func (in *ServiceBindingProjectionStatus) DeepCopyInto(out *ServiceBindingProjectionStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -140,6 +190,21 @@ func (in *WorkloadReference) DeepCopyInto(out *WorkloadReference) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludeInitContainers != nil {
+		in, out := &in.IncludeInitContainers, &out.IncludeInitContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeEphemeralContainers != nil {
+		in, out := &in.IncludeEphemeralContainers, &out.IncludeEphemeralContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeContainers != nil {
+		in, out := &in.ExcludeContainers, &out.ExcludeContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -152,3 +217,45 @@ func (in *WorkloadReference) DeepCopy() *WorkloadReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
+func (in *WorkloadStatus) DeepCopy() *WorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadsSummary) DeepCopyInto(out *WorkloadsSummary) {
+	*out = *in
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadsSummary.
+func (in *WorkloadsSummary) DeepCopy() *WorkloadsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha3
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+// ConvertTo implements apis.Convertible. As the hub, the ServiceBinding is
+// converted by the other versions, which know the hub.
+func (source *ServiceBinding) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	if _, ok := to.(*ServiceBinding); ok {
+		return fmt.Errorf("unknown version, got: %T", to)
+	}
+	return to.ConvertFrom(ctx, source)
+}
+
+// ConvertFrom implements apis.Convertible. As the hub, the ServiceBinding is
+// converted by the other versions, which know the hub.
+func (sink *ServiceBinding) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	if _, ok := from.(*ServiceBinding); ok {
+		return fmt.Errorf("unknown version, got: %T", from)
+	}
+	return from.ConvertTo(ctx, sink)
+}
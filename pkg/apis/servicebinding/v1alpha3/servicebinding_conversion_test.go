@@ -0,0 +1,56 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha3
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/apis"
+)
+
+// convertible records the conversions delegated to it by the hub
+type convertible struct {
+	from apis.Convertible
+	to   apis.Convertible
+}
+
+func (c *convertible) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	c.to = to
+	return nil
+}
+
+func (c *convertible) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	c.from = from
+	return nil
+}
+
+func TestServiceBinding_Conversion(t *testing.T) {
+	hub := &ServiceBinding{}
+	other := &convertible{}
+	if err := hub.ConvertTo(context.TODO(), other); err != nil {
+		t.Errorf("ConvertTo() unexpected error: %v", err)
+	}
+	if other.from != hub {
+		t.Errorf("ConvertTo() expected to delegate to the sink's ConvertFrom")
+	}
+	if err := hub.ConvertFrom(context.TODO(), other); err != nil {
+		t.Errorf("ConvertFrom() unexpected error: %v", err)
+	}
+	if other.to != hub {
+		t.Errorf("ConvertFrom() expected to delegate to the source's ConvertTo")
+	}
+}
+
+func TestServiceBinding_ConversionUnknownVersion(t *testing.T) {
+	in := &ServiceBinding{}
+	if err := in.ConvertTo(context.TODO(), &ServiceBinding{}); err == nil {
+		t.Errorf("ConvertTo() expected error")
+	}
+	if err := in.ConvertFrom(context.TODO(), &ServiceBinding{}); err == nil {
+		t.Errorf("ConvertFrom() expected error")
+	}
+}
@@ -7,6 +7,7 @@ package v1alpha3
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
@@ -19,14 +20,23 @@ const (
 	ServiceBindingConditionReady            = "Ready"
 	ServiceBindingConditionServiceAvailable = "ServiceAvailable"
 	ServiceBindingConditionProjectionReady  = "ProjectionReady"
-	InitializeConditionReason               = "Unknown"
+	ServiceBindingConditionUnbound          = "Unbound"
+	// ServiceBindingConditionContainersMatched is only present when the
+	// workload reference names the containers to target
+	ServiceBindingConditionContainersMatched = "ContainersMatched"
+	// ServiceBindingConditionSecretValid is only present once the entries of
+	// the binding Secret are checked against the schema of its type, the
+	// ServiceBinding is not Ready while it is False
+	ServiceBindingConditionSecretValid = "SecretValid"
+	InitializeConditionReason          = "Unknown"
 )
 
 func (bs *ServiceBindingStatus) InitializeConditions(now metav1.Time) {
 	ready := metav1.Condition{Type: ServiceBindingConditionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason}
 	serviceAvailable := metav1.Condition{Type: ServiceBindingConditionServiceAvailable, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason}
 	projectionReady := metav1.Condition{Type: ServiceBindingConditionProjectionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason}
-	for _, c := range bs.Conditions {
+	var secretValid, containersMatched, unbound *metav1.Condition
+	for i, c := range bs.Conditions {
 		switch c.Type {
 		case ServiceBindingConditionReady:
 			ready = c
@@ -34,9 +44,25 @@ func (bs *ServiceBindingStatus) InitializeConditions(now metav1.Time) {
 			serviceAvailable = c
 		case ServiceBindingConditionProjectionReady:
 			projectionReady = c
+		case ServiceBindingConditionSecretValid:
+			secretValid = &bs.Conditions[i]
+		case ServiceBindingConditionContainersMatched:
+			containersMatched = &bs.Conditions[i]
+		case ServiceBindingConditionUnbound:
+			unbound = &bs.Conditions[i]
 		}
 	}
-	bs.Conditions = []metav1.Condition{ready, serviceAvailable, projectionReady}
+	conditions := []metav1.Condition{ready, serviceAvailable, projectionReady}
+	if secretValid != nil {
+		conditions = append(conditions, *secretValid)
+	}
+	if containersMatched != nil {
+		conditions = append(conditions, *containersMatched)
+	}
+	if unbound != nil {
+		conditions = append(conditions, *unbound)
+	}
+	bs.Conditions = conditions
 }
 
 func (bs *ServiceBindingStatus) MarkServiceAvailable(now metav1.Time) {
@@ -61,6 +87,66 @@ func (bs *ServiceBindingStatus) MarkServiceUnavailable(reason string, message st
 	bs.aggregateReadyCondition(now)
 }
 
+// MarkServicesAvailable aggregates the status of each of the Services into
+// the ServiceAvailable condition, once the service itself is available. The
+// reason of the first unavailable service is reported.
+func (bs *ServiceBindingStatus) MarkServicesAvailable(now metav1.Time) {
+	reason := ""
+	messages := []string{}
+	for _, s := range bs.Services {
+		if s.Available {
+			continue
+		}
+		if reason == "" {
+			reason = s.Reason
+		}
+		messages = append(messages, fmt.Sprintf("service %q: %s", s.Name, s.Message))
+	}
+	if len(messages) == 0 {
+		bs.MarkServiceAvailable(now)
+		return
+	}
+	bs.MarkServiceUnavailable(reason, strings.Join(messages, "; "), now)
+}
+
+// MarkSecretValid reports the binding Secret contains the entries required
+// by its type.
+func (bs *ServiceBindingStatus) MarkSecretValid(reason string, message string, now metav1.Time) {
+	bs.setSecretValid(metav1.ConditionTrue, reason, message, now)
+}
+
+// MarkSecretInvalid reports the binding Secret is missing entries required
+// by its type.
+func (bs *ServiceBindingStatus) MarkSecretInvalid(reason string, message string, now metav1.Time) {
+	bs.setSecretValid(metav1.ConditionFalse, reason, message, now)
+}
+
+func (bs *ServiceBindingStatus) setSecretValid(status metav1.ConditionStatus, reason string, message string, now metav1.Time) {
+	secretValid := metav1.Condition{Type: ServiceBindingConditionSecretValid, Status: status, LastTransitionTime: now, Reason: reason, Message: message}
+	for i := range bs.Conditions {
+		if bs.Conditions[i].Type == ServiceBindingConditionSecretValid {
+			if bs.Conditions[i].Status == secretValid.Status {
+				secretValid.LastTransitionTime = bs.Conditions[i].LastTransitionTime
+			}
+			bs.Conditions[i] = secretValid
+			return
+		}
+	}
+	bs.Conditions = append(bs.Conditions, secretValid)
+}
+
+// ClearSecretValid removes the SecretValid condition while there is no
+// binding Secret to check.
+func (bs *ServiceBindingStatus) ClearSecretValid() {
+	conditions := []metav1.Condition{}
+	for _, c := range bs.Conditions {
+		if c.Type != ServiceBindingConditionSecretValid {
+			conditions = append(conditions, c)
+		}
+	}
+	bs.Conditions = conditions
+}
+
 func (bs *ServiceBindingStatus) PropagateServiceBindingProjectionStatus(bp *labsinternalv1alpha1.ServiceBindingProjection, now metav1.Time) {
 	if bp == nil {
 		return
@@ -87,13 +173,87 @@ func (bs *ServiceBindingStatus) PropagateServiceBindingProjectionStatus(bp *labs
 		bs.Conditions[2].Reason = "Unknown"
 	}
 	bs.Conditions[2].Message = sbpready.Message
+	bs.Workloads = bp.Status.SummarizeWorkloads()
+	bs.SecretHash = bp.Status.SecretHash
+	bs.propagateContainersMatched(bp, now)
 
 	bs.aggregateReadyCondition(now)
 }
 
+// propagateContainersMatched mirrors the ContainersMatched condition of the
+// ServiceBindingProjection, removing the condition when the projection does
+// not report it.
+func (bs *ServiceBindingStatus) propagateContainersMatched(bp *labsinternalv1alpha1.ServiceBindingProjection, now metav1.Time) {
+	var existing *metav1.Condition
+	conditions := make([]metav1.Condition, 0, len(bs.Conditions)+1)
+	for i := range bs.Conditions {
+		if bs.Conditions[i].Type == ServiceBindingConditionContainersMatched {
+			existing = &bs.Conditions[i]
+			continue
+		}
+		conditions = append(conditions, bs.Conditions[i])
+	}
+	if c := bp.Status.GetCondition(labsinternalv1alpha1.ServiceBindingProjectionConditionContainersMatched); c != nil {
+		matched := metav1.Condition{
+			Type:               ServiceBindingConditionContainersMatched,
+			Status:             metav1.ConditionStatus(c.Status),
+			LastTransitionTime: now,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		}
+		if matched.Reason == "" {
+			matched.Reason = "Matched"
+		}
+		if existing != nil && existing.Status == matched.Status {
+			matched.LastTransitionTime = existing.LastTransitionTime
+		}
+		conditions = append(conditions, matched)
+	}
+	bs.Conditions = conditions
+}
+
+// PropagateServiceBindingProjectionUnbinding reports the progress of the
+// ServiceBindingProjection removing the binding from the workloads while the
+// ServiceBinding is deleted.
+func (bs *ServiceBindingStatus) PropagateServiceBindingProjectionUnbinding(bp *labsinternalv1alpha1.ServiceBindingProjection, now metav1.Time) {
+	if bp == nil {
+		return
+	}
+	reason := "Unbinding"
+	message := fmt.Sprintf("waiting for ServiceBindingProjection %q to unbind workloads", bp.Name)
+	if c := bp.Status.GetCondition(labsinternalv1alpha1.ServiceBindingProjectionConditionUnbound); c != nil && c.IsFalse() {
+		reason = c.Reason
+		message = c.Message
+	}
+	bs.Workloads = bp.Status.SummarizeWorkloads()
+	bs.MarkUnbinding(reason, message, now)
+}
+
+// MarkUnbinding sets the Unbound condition, which is only present while the
+// ServiceBinding is deleted.
+func (bs *ServiceBindingStatus) MarkUnbinding(reason string, message string, now metav1.Time) {
+	unbound := metav1.Condition{Type: ServiceBindingConditionUnbound, Status: metav1.ConditionFalse, LastTransitionTime: now, Reason: reason, Message: message}
+	for i := range bs.Conditions {
+		if bs.Conditions[i].Type == ServiceBindingConditionUnbound {
+			if bs.Conditions[i].Status == unbound.Status {
+				unbound.LastTransitionTime = bs.Conditions[i].LastTransitionTime
+			}
+			bs.Conditions[i] = unbound
+			return
+		}
+	}
+	bs.Conditions = append(bs.Conditions, unbound)
+}
+
 func (bs *ServiceBindingStatus) aggregateReadyCondition(now metav1.Time) {
 	currentStatus := bs.Conditions[0].Status
-	if bs.Conditions[1].Status == metav1.ConditionTrue && bs.Conditions[2].Status == metav1.ConditionTrue {
+	secretValid := metav1.Condition{Status: metav1.ConditionTrue}
+	for _, c := range bs.Conditions {
+		if c.Type == ServiceBindingConditionSecretValid {
+			secretValid = c
+		}
+	}
+	if bs.Conditions[1].Status == metav1.ConditionTrue && bs.Conditions[2].Status == metav1.ConditionTrue && secretValid.Status != metav1.ConditionFalse {
 		bs.Conditions[0].Status = metav1.ConditionTrue
 		bs.Conditions[0].Reason = "Ready"
 		bs.Conditions[0].Message = ""
@@ -101,6 +261,10 @@ func (bs *ServiceBindingStatus) aggregateReadyCondition(now metav1.Time) {
 		bs.Conditions[0].Status = metav1.ConditionFalse
 		bs.Conditions[0].Reason = fmt.Sprintf("%s%s", ServiceBindingConditionServiceAvailable, bs.Conditions[1].Reason)
 		bs.Conditions[0].Message = bs.Conditions[1].Message
+	} else if secretValid.Status == metav1.ConditionFalse {
+		bs.Conditions[0].Status = metav1.ConditionFalse
+		bs.Conditions[0].Reason = fmt.Sprintf("%s%s", ServiceBindingConditionSecretValid, secretValid.Reason)
+		bs.Conditions[0].Message = secretValid.Message
 	} else if bs.Conditions[2].Status == metav1.ConditionFalse {
 		bs.Conditions[0].Status = metav1.ConditionFalse
 		bs.Conditions[0].Reason = fmt.Sprintf("%s%s", ServiceBindingConditionProjectionReady, bs.Conditions[2].Reason)
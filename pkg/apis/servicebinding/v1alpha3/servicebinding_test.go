@@ -7,13 +7,18 @@ package v1alpha3
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/tracker"
@@ -78,6 +83,194 @@ func TestServiceBinding_SetDefaults(t *testing.T) {
 	}
 }
 
+type fakeServiceBindingClassLister []*labsv1alpha1.ServiceBindingClass
+
+func (l fakeServiceBindingClassLister) classes(namespace string) labsv1alpha1.ServiceBindingClassNamespaceLister {
+	classes := fakeServiceBindingClassLister{}
+	for _, c := range l {
+		if c.Namespace == namespace {
+			classes = append(classes, c)
+		}
+	}
+	return classes
+}
+
+func (l fakeServiceBindingClassLister) List(labels.Selector) ([]*labsv1alpha1.ServiceBindingClass, error) {
+	return l, nil
+}
+
+func (l fakeServiceBindingClassLister) Get(name string) (*labsv1alpha1.ServiceBindingClass, error) {
+	for _, c := range l {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, apierrs.NewNotFound(labsv1alpha1.Resource("servicebindingclasses"), name)
+}
+
+func TestServiceBinding_SetDefaults_Class(t *testing.T) {
+	service := &tracker.Reference{
+		APIVersion: "bindings.labs.vmware.com/v1alpha1",
+		Kind:       "ProvisionedService",
+		Name:       "my-service",
+	}
+	postgres := &labsv1alpha1.ServiceBindingClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "postgres",
+		},
+		Spec: labsv1alpha1.ServiceBindingClassSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"database": "postgres"},
+			},
+			Type:     "postgresql",
+			Provider: "bitnami",
+			Env: []labsinternalv1alpha1.EnvVar{
+				{Name: "DB_HOST", Key: "host"},
+				{Name: "DB_USER", Key: "username"},
+			},
+			Containers: []string{"app"},
+			MountPath:  "/etc/db",
+		},
+	}
+	other := &labsv1alpha1.ServiceBindingClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-namespace",
+			Name:      "other",
+		},
+		Spec: labsv1alpha1.ServiceBindingClassSpec{
+			Selector: &metav1.LabelSelector{},
+			Type:     "mysql",
+		},
+	}
+	lister := fakeServiceBindingClassLister{other, postgres}
+	labeled := func(serviceLabels map[string]string) labsv1alpha1.ServiceLabelsFunc {
+		return func(ctx context.Context, ref tracker.Reference) (map[string]string, error) {
+			if ref.Namespace != "my-namespace" || ref.Name != "my-service" {
+				return nil, fmt.Errorf("unexpected service %+v", ref)
+			}
+			return serviceLabels, nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		serviceLabels labsv1alpha1.ServiceLabelsFunc
+		seed          ServiceBindingSpec
+		expected      ServiceBindingSpec
+	}{
+		{
+			name: "named class",
+			seed: ServiceBindingSpec{
+				Name:     "my-binding",
+				Service:  service,
+				Workload: &WorkloadReference{},
+				Class:    "postgres",
+			},
+			expected: ServiceBindingSpec{
+				Name:     "my-binding",
+				Type:     "postgresql",
+				Provider: "bitnami",
+				Service:  service,
+				Workload: &WorkloadReference{Containers: []string{"app"}},
+				Env: []EnvVar{
+					{Name: "DB_HOST", Key: "host"},
+					{Name: "DB_USER", Key: "username"},
+				},
+				MountPath: "/etc/db",
+				Class:     "postgres",
+			},
+		},
+		{
+			name: "binding wins",
+			seed: ServiceBindingSpec{
+				Name:     "my-binding",
+				Type:     "postgres",
+				Service:  service,
+				Workload: &WorkloadReference{Containers: []string{"main"}},
+				Env: []EnvVar{
+					{Name: "DB_HOST", Key: "hostname"},
+				},
+				MountPath: "/bindings/db",
+				Class:     "postgres",
+			},
+			expected: ServiceBindingSpec{
+				Name:     "my-binding",
+				Type:     "postgres",
+				Provider: "bitnami",
+				Service:  service,
+				Workload: &WorkloadReference{Containers: []string{"main"}},
+				Env: []EnvVar{
+					{Name: "DB_HOST", Key: "hostname"},
+					{Name: "DB_USER", Key: "username"},
+				},
+				MountPath: "/bindings/db",
+				Class:     "postgres",
+			},
+		},
+		{
+			name: "missing class",
+			seed: ServiceBindingSpec{
+				Name:    "my-binding",
+				Service: service,
+				Class:   "other",
+			},
+			expected: ServiceBindingSpec{
+				Name:    "my-binding",
+				Service: service,
+				Class:   "other",
+			},
+		},
+		{
+			name:          "selected by service labels",
+			serviceLabels: labeled(map[string]string{"database": "postgres"}),
+			seed: ServiceBindingSpec{
+				Name:    "my-binding",
+				Service: service,
+			},
+			expected: ServiceBindingSpec{
+				Name:     "my-binding",
+				Type:     "postgresql",
+				Provider: "bitnami",
+				Service:  service,
+				Env: []EnvVar{
+					{Name: "DB_HOST", Key: "host"},
+					{Name: "DB_USER", Key: "username"},
+				},
+				MountPath: "/etc/db",
+			},
+		},
+		{
+			name:          "not selected by service labels",
+			serviceLabels: labeled(map[string]string{"database": "mysql"}),
+			seed: ServiceBindingSpec{
+				Name:    "my-binding",
+				Service: service,
+			},
+			expected: ServiceBindingSpec{
+				Name:    "my-binding",
+				Service: service,
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := labsv1alpha1.WithServiceBindingClasses(context.TODO(), lister.classes, c.serviceLabels)
+			actual := &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding",
+				},
+				Spec: c.seed,
+			}
+			actual.SetDefaults(ctx)
+			if diff := cmp.Diff(c.expected, actual.Spec); diff != "" {
+				t.Errorf("%s: SetDefaults() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
 func TestServiceBinding_Validate(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -181,7 +374,7 @@ func TestServiceBinding_Validate(t *testing.T) {
 			expected: nil,
 		},
 		{
-			name: "disallow namespaces",
+			name: "disallow workload namespace, allow service namespace",
 			seed: &ServiceBinding{
 				Spec: ServiceBindingSpec{
 					Workload: &WorkloadReference{
@@ -202,7 +395,6 @@ func TestServiceBinding_Validate(t *testing.T) {
 			},
 			expected: (&apis.FieldError{}).Also(
 				apis.ErrDisallowedFields("spec.workload.namespace"),
-				apis.ErrDisallowedFields("spec.service.namespace"),
 			),
 		},
 		{
@@ -260,190 +452,879 @@ func TestServiceBinding_Validate(t *testing.T) {
 				),
 			),
 		},
-	}
-	for _, c := range tests {
-		t.Run(c.name, func(t *testing.T) {
-			actual := c.seed.Validate(context.TODO())
-			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
-				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
-			}
-		})
-	}
-}
-
-func TestServiceBindingStatus_PropagateServiceBindingProjectionStatus(t *testing.T) {
-	now := metav1.Now()
-
-	tests := []struct {
-		name       string
-		seed       *ServiceBindingStatus
-		projection *labsinternalv1alpha1.ServiceBindingProjection
-		expected   *ServiceBindingStatus
-	}{
 		{
-			name:       "empty",
-			seed:       &ServiceBindingStatus{},
-			projection: nil,
-			expected: &ServiceBindingStatus{
-				Conditions: []metav1.Condition{
-					{Type: ServiceBindingConditionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason},
-					{Type: ServiceBindingConditionServiceAvailable, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason},
-					{Type: ServiceBindingConditionProjectionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason},
+			name: "duplicate env after another name",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Env: []EnvVar{
+						{Name: "MY_OTHER_VAR", Key: "my-key0"},
+						{Name: "MY_VAR", Key: "my-key1"},
+						{Name: "MY_VAR", Key: "my-key2"},
+					},
 				},
 			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMultipleOneOf(
+					"spec.env[1].name",
+					"spec.env[2].name",
+				),
+			),
 		},
 		{
-			name:       "default",
-			seed:       &ServiceBindingStatus{},
-			projection: &labsinternalv1alpha1.ServiceBindingProjection{},
-			expected: &ServiceBindingStatus{
-				Conditions: []metav1.Condition{
-					{
-						Type:               ServiceBindingConditionReady,
-						Status:             metav1.ConditionUnknown,
-						Reason:             "ServiceAvailableUnknown",
-						LastTransitionTime: now,
+			name: "valid, fields",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
 					},
-					{
-						Type:               ServiceBindingConditionServiceAvailable,
-						LastTransitionTime: now,
-						Status:             metav1.ConditionUnknown,
-						Reason:             InitializeConditionReason,
+					Service: &tracker.Reference{
+						APIVersion: "example.com/v1",
+						Kind:       "Database",
+						Name:       "my-service",
 					},
-					{
-						Type:               ServiceBindingConditionProjectionReady,
-						Status:             metav1.ConditionUnknown,
-						Reason:             "Unknown",
-						LastTransitionTime: now,
+					Fields: []ServiceField{
+						{Key: "host", JSONPath: ".status.address.host"},
+						{Key: "port", JSONPath: ".status.address.port"},
 					},
 				},
 			},
+			expected: nil,
 		},
 		{
-			name: "ready",
-			seed: &ServiceBindingStatus{},
-			projection: &labsinternalv1alpha1.ServiceBindingProjection{
-				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
-					Status: duckv1.Status{
-						Conditions: duckv1.Conditions{
-							{
-								Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
-								Status: corev1.ConditionTrue,
-							},
+			name: "invalid fields",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
 						},
 					},
+					Service: &tracker.Reference{
+						APIVersion: "example.com/v1",
+						Kind:       "Database",
+						Name:       "my-service",
+					},
+					Fields: []ServiceField{
+						{},
+						{Key: "not/a/key", JSONPath: "{.status"},
+					},
 				},
 			},
-			expected: &ServiceBindingStatus{
-				Conditions: []metav1.Condition{
-					{
-						Type:               ServiceBindingConditionReady,
-						Status:             metav1.ConditionUnknown,
-						Reason:             "ServiceAvailableUnknown",
-						LastTransitionTime: now,
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.fields[0].key"),
+				apis.ErrMissingField("spec.fields[0].jsonPath"),
+				apis.ErrInvalidValue("not/a/key", "spec.fields[1].key"),
+				apis.ErrInvalidValue("{.status", "spec.fields[1].jsonPath"),
+			),
+		},
+		{
+			name: "duplicate fields",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
 					},
-					{
-						Type:               ServiceBindingConditionServiceAvailable,
-						LastTransitionTime: now,
-						Status:             metav1.ConditionUnknown,
-						Reason:             InitializeConditionReason,
+					Service: &tracker.Reference{
+						APIVersion: "example.com/v1",
+						Kind:       "Database",
+						Name:       "my-service",
 					},
-					{
-						Type:               ServiceBindingConditionProjectionReady,
-						Status:             metav1.ConditionTrue,
-						Reason:             "Projected",
-						LastTransitionTime: now,
+					Fields: []ServiceField{
+						{Key: "host", JSONPath: ".status.host"},
+						{Key: "host", JSONPath: ".status.address.host"},
 					},
 				},
 			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMultipleOneOf(
+					"spec.fields[0].key",
+					"spec.fields[1].key",
+				),
+			),
 		},
 		{
-			name: "not ready",
-			seed: &ServiceBindingStatus{},
-			projection: &labsinternalv1alpha1.ServiceBindingProjection{
-				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
-					Status: duckv1.Status{
-						Conditions: duckv1.Conditions{
-							{
-								Type:    labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
-								Status:  corev1.ConditionFalse,
-								Reason:  "TheReason",
-								Message: "the message",
-							},
+			name: "valid, mappings",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
 						},
 					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri", From: "connection-string"},
+						{Key: "password", Drop: true},
+						{Key: "credentials", Template: "{{ .username }}:{{ .password }}"},
+					},
 				},
 			},
-			expected: &ServiceBindingStatus{
-				Conditions: []metav1.Condition{
-					{
-						Type:               ServiceBindingConditionReady,
-						Status:             metav1.ConditionFalse,
-						Reason:             "ProjectionReadyTheReason",
-						Message:            "the message",
-						LastTransitionTime: now,
+			expected: nil,
+		},
+		{
+			name: "invalid mappings",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
 					},
-					{
-						Type:               ServiceBindingConditionServiceAvailable,
-						LastTransitionTime: now,
-						Status:             metav1.ConditionUnknown,
-						Reason:             InitializeConditionReason,
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri"},
+						{Key: "uri", Template: "{{ .host"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingOneOf("spec.mappings[0].from", "spec.mappings[0].template", "spec.mappings[0].drop"),
+				apis.ErrInvalidValue("{{ .host", "spec.mappings[1].template"),
+				apis.ErrMultipleOneOf(
+					"spec.mappings[0].key",
+					"spec.mappings[1].key",
+				),
+			),
+		},
+		{
+			name: "valid, services",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-db",
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Services: []BoundService{
+						{
+							Name: "my-cache",
+							Type: "redis",
+							Service: &tracker.Reference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-cache-secret",
+							},
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid services",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Name: "my-db",
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Services: []BoundService{
+						{
+							Name: "my-db",
+							Service: &tracker.Reference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-db-secret",
+							},
+						},
+						{
+							Name: "my/cache",
+							Service: &tracker.Reference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Namespace:  "other-namespace",
+								Name:       "my-cache-secret",
+							},
+						},
+						{},
+						{
+							Name: "my/cache",
+							Service: &tracker.Reference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+							},
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("my-db", "spec.services[0].name"),
+				apis.ErrInvalidValue("my/cache", "spec.services[1].name"),
+				apis.ErrDisallowedFields("spec.services[1].service.namespace"),
+				apis.ErrMissingField("spec.services[2].name"),
+				apis.ErrMissingField("spec.services[2].service"),
+				apis.ErrInvalidValue("my/cache", "spec.services[3].name"),
+				apis.ErrMissingOneOf("spec.services[3].service.name", "spec.services[3].service.selector"),
+				apis.ErrMissingField("spec.services[3].service.name"),
+				apis.ErrMultipleOneOf("spec.services[1].name", "spec.services[3].name"),
+			),
+		},
+		{
+			name: "invalid mount path",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					MountPath: "etc/db",
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("etc/db", "spec.mountPath"),
+			),
+		},
+		{
+			name: "invalid class",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Class: "My_Class",
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidValue("My_Class", "spec.class"),
+			),
+		},
+		{
+			name: "invalid container pattern",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+						Containers:        []string{"app-*"},
+						ExcludeContainers: []string{"sidecar-["},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrInvalidArrayValue("sidecar-[", "spec.workload.excludeContainers", 0),
+			),
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.Validate(context.TODO())
+			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
+				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBinding_Validate_Class(t *testing.T) {
+	postgres := &labsv1alpha1.ServiceBindingClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "postgres",
+		},
+	}
+	lister := fakeServiceBindingClassLister{postgres}
+	binding := func(class string) *ServiceBinding {
+		return &ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-binding",
+			},
+			Spec: ServiceBindingSpec{
+				Workload: &WorkloadReference{
+					Reference: tracker.Reference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "my-app",
+					},
+				},
+				Service: &tracker.Reference{
+					APIVersion: "bindings.labs.vmware.com/v1alpha1",
+					Kind:       "ProvisionedService",
+					Name:       "my-service",
+				},
+				Class: class,
+			},
+		}
+	}
+	ctx := labsv1alpha1.WithServiceBindingClasses(context.TODO(), lister.classes, nil)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		seed     *ServiceBinding
+		expected *apis.FieldError
+	}{
+		{
+			name:     "class exists",
+			ctx:      apis.WithinCreate(ctx),
+			seed:     binding("postgres"),
+			expected: nil,
+		},
+		{
+			name: "missing class",
+			ctx:  apis.WithinCreate(ctx),
+			seed: binding("other"),
+			expected: &apis.FieldError{
+				Message: `failed to get ServiceBindingClass "other": servicebindingclasses.bindings.labs.vmware.com "other" not found`,
+				Paths:   []string{"spec.class"},
+			},
+		},
+		{
+			name:     "missing class unchanged on update",
+			ctx:      apis.WithinUpdate(ctx, binding("other")),
+			seed:     binding("other"),
+			expected: nil,
+		},
+		{
+			name: "missing class changed on update",
+			ctx:  apis.WithinUpdate(ctx, binding("postgres")),
+			seed: binding("other"),
+			expected: &apis.FieldError{
+				Message: `failed to get ServiceBindingClass "other": servicebindingclasses.bindings.labs.vmware.com "other" not found`,
+				Paths:   []string{"spec.class"},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.Validate(c.ctx)
+			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
+				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingStatus_PropagateServiceBindingProjectionStatus(t *testing.T) {
+	now := metav1.Now()
+
+	tests := []struct {
+		name       string
+		seed       *ServiceBindingStatus
+		projection *labsinternalv1alpha1.ServiceBindingProjection
+		expected   *ServiceBindingStatus
+	}{
+		{
+			name:       "empty",
+			seed:       &ServiceBindingStatus{},
+			projection: nil,
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason},
+					{Type: ServiceBindingConditionServiceAvailable, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason},
+					{Type: ServiceBindingConditionProjectionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: InitializeConditionReason},
+				},
+			},
+		},
+		{
+			name:       "default",
+			seed:       &ServiceBindingStatus{},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "ServiceAvailableUnknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Unknown",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		},
+		{
+			name: "ready",
+			seed: &ServiceBindingStatus{},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "ServiceAvailableUnknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionTrue,
+						Reason:             "Projected",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		},
+		{
+			name: "not ready",
+			seed: &ServiceBindingStatus{},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:    labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+								Status:  corev1.ConditionFalse,
+								Reason:  "TheReason",
+								Message: "the message",
+							},
+						},
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionFalse,
+						Reason:             "ProjectionReadyTheReason",
+						Message:            "the message",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionFalse,
+						Reason:             "TheReason",
+						Message:            "the message",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		},
+		{
+			name: "unknown",
+			seed: &ServiceBindingStatus{},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type: labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+							},
+						},
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "ServiceAvailableUnknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Unknown",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		},
+		{
+			name: "workloads",
+			seed: &ServiceBindingStatus{},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:    labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+								Status:  corev1.ConditionFalse,
+								Reason:  "WorkloadBindingFailed",
+								Message: "the message",
+							},
+						},
+					},
+					Workloads: []labsinternalv1alpha1.WorkloadStatus{
+						{Name: "my-workload-1", ObservedGeneration: 1, Injected: true},
+						{Name: "my-workload-2", ObservedGeneration: 2, Error: "the message"},
+						{Name: "my-workload-3", ObservedGeneration: 3, Injected: true},
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionFalse,
+						Reason:             "ProjectionReadyWorkloadBindingFailed",
+						Message:            "the message",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionFalse,
+						Reason:             "WorkloadBindingFailed",
+						Message:            "the message",
+						LastTransitionTime: now,
+					},
+				},
+				Workloads: &WorkloadsSummary{
+					Matched:  3,
+					Injected: 2,
+					Failed:   []string{"my-workload-2"},
+				},
+			},
+		},
+		{
+			name: "secret hash",
+			seed: &ServiceBindingStatus{
+				SecretHash: "my-previous-hash",
+			},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					SecretHash: "my-hash",
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "ServiceAvailableUnknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Unknown",
+						LastTransitionTime: now,
+					},
+				},
+				SecretHash: "my-hash",
+			},
+		},
+		{
+			name: "containers not matched",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionContainersMatched,
+						Status: metav1.ConditionTrue,
+						Reason: "Matched",
+					},
+				},
+			},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:    labsinternalv1alpha1.ServiceBindingProjectionConditionContainersMatched,
+								Status:  corev1.ConditionFalse,
+								Reason:  "ContainerNotFound",
+								Message: "the message",
+							},
+						},
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "ServiceAvailableUnknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Unknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionContainersMatched,
+						Status:             metav1.ConditionFalse,
+						Reason:             "ContainerNotFound",
+						Message:            "the message",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		},
+		{
+			name: "containers matched cleared",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionContainersMatched,
+						Status: metav1.ConditionFalse,
+						Reason: "ContainerNotFound",
+					},
+				},
+			},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "ServiceAvailableUnknown",
+						LastTransitionTime: now,
+					},
+					{
+						Type:               ServiceBindingConditionServiceAvailable,
+						LastTransitionTime: now,
+						Status:             metav1.ConditionUnknown,
+						Reason:             InitializeConditionReason,
+					},
+					{
+						Type:               ServiceBindingConditionProjectionReady,
+						Status:             metav1.ConditionUnknown,
+						Reason:             "Unknown",
+						LastTransitionTime: now,
+					},
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.InitializeConditions(now)
+			actual.PropagateServiceBindingProjectionStatus(c.projection, now)
+			if diff := cmp.Diff(c.expected, actual, cmpopts.IgnoreTypes(metav1.Time{})); diff != "" {
+				t.Errorf("%s: PropagateServiceBindingProjectionStatus() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBindingStatus_PropagateServiceBindingProjectionUnbinding(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Minute))
+	tests := []struct {
+		name       string
+		seed       *ServiceBindingStatus
+		projection *labsinternalv1alpha1.ServiceBindingProjection
+		expected   *ServiceBindingStatus
+	}{
+		{
+			name:       "no projection",
+			seed:       &ServiceBindingStatus{},
+			projection: nil,
+			expected:   &ServiceBindingStatus{},
+		},
+		{
+			name: "unbinding",
+			seed: &ServiceBindingStatus{},
+			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Workloads: []labsinternalv1alpha1.WorkloadStatus{
+						{Name: "my-workload", Injected: true},
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionUnbound,
+						Status:             metav1.ConditionFalse,
+						Reason:             "Unbinding",
+						Message:            `waiting for ServiceBindingProjection "my-binding" to unbind workloads`,
+						LastTransitionTime: later,
 					},
+				},
+				Workloads: &WorkloadsSummary{
+					Matched:  1,
+					Injected: 1,
+				},
+			},
+		},
+		{
+			name: "unbind failed",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
 					{
-						Type:               ServiceBindingConditionProjectionReady,
+						Type:               ServiceBindingConditionUnbound,
 						Status:             metav1.ConditionFalse,
-						Reason:             "TheReason",
-						Message:            "the message",
+						Reason:             "Unbinding",
+						Message:            `waiting for ServiceBindingProjection "my-binding" to unbind workloads`,
 						LastTransitionTime: now,
 					},
 				},
 			},
-		},
-		{
-			name: "unknown",
-			seed: &ServiceBindingStatus{},
 			projection: &labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
 				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
 					Status: duckv1.Status{
 						Conditions: duckv1.Conditions{
 							{
-								Type: labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+								Type:    labsinternalv1alpha1.ServiceBindingProjectionConditionUnbound,
+								Status:  corev1.ConditionFalse,
+								Reason:  "UnbindFailed",
+								Message: "1 of 1 workloads still bound: inducing failure",
 							},
 						},
 					},
+					Workloads: []labsinternalv1alpha1.WorkloadStatus{
+						{Name: "my-workload", Injected: true},
+					},
 				},
 			},
 			expected: &ServiceBindingStatus{
 				Conditions: []metav1.Condition{
 					{
-						Type:               ServiceBindingConditionReady,
-						Status:             metav1.ConditionUnknown,
-						Reason:             "ServiceAvailableUnknown",
-						LastTransitionTime: now,
-					},
-					{
-						Type:               ServiceBindingConditionServiceAvailable,
-						LastTransitionTime: now,
-						Status:             metav1.ConditionUnknown,
-						Reason:             InitializeConditionReason,
-					},
-					{
-						Type:               ServiceBindingConditionProjectionReady,
-						Status:             metav1.ConditionUnknown,
-						Reason:             "Unknown",
+						Type:               ServiceBindingConditionUnbound,
+						Status:             metav1.ConditionFalse,
+						Reason:             "UnbindFailed",
+						Message:            "1 of 1 workloads still bound: inducing failure",
 						LastTransitionTime: now,
 					},
 				},
+				Workloads: &WorkloadsSummary{
+					Matched:  1,
+					Injected: 1,
+				},
 			},
 		},
 	}
 	for _, c := range tests {
 		t.Run(c.name, func(t *testing.T) {
 			actual := c.seed.DeepCopy()
-			actual.InitializeConditions(now)
-			actual.PropagateServiceBindingProjectionStatus(c.projection, now)
-			if diff := cmp.Diff(c.expected, actual, cmpopts.IgnoreTypes(metav1.Time{})); diff != "" {
-				t.Errorf("%s: PropagateServiceBindingProjectionStatus() (-expected, +actual): %s", c.name, diff)
+			actual.PropagateServiceBindingProjectionUnbinding(c.projection, later)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: PropagateServiceBindingProjectionUnbinding() (-expected, +actual): %s", c.name, diff)
 			}
 		})
 	}
@@ -477,6 +1358,61 @@ func TestServiceBindingStatus_MarkServiceAvailable(t *testing.T) {
 	}
 }
 
+func TestServiceBindingStatus_MarkServicesAvailable(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name     string
+		services []BoundServiceStatus
+		expected metav1.Condition
+	}{
+		{
+			name: "no services",
+			expected: metav1.Condition{
+				Type:   ServiceBindingConditionServiceAvailable,
+				Status: metav1.ConditionTrue,
+				Reason: "Available",
+			},
+		},
+		{
+			name: "services available",
+			services: []BoundServiceStatus{
+				{Name: "my-cache", Available: true, Binding: &corev1.LocalObjectReference{Name: "my-cache-secret"}},
+				{Name: "my-queue", Available: true, Binding: &corev1.LocalObjectReference{Name: "my-queue-secret"}},
+			},
+			expected: metav1.Condition{
+				Type:   ServiceBindingConditionServiceAvailable,
+				Status: metav1.ConditionTrue,
+				Reason: "Available",
+			},
+		},
+		{
+			name: "services unavailable",
+			services: []BoundServiceStatus{
+				{Name: "my-cache", Reason: "ServiceNotFound", Message: "not found"},
+				{Name: "my-queue", Available: true, Binding: &corev1.LocalObjectReference{Name: "my-queue-secret"}},
+				{Name: "my-db", Reason: "BindingUnavailable", Message: "no binding"},
+			},
+			expected: metav1.Condition{
+				Type:    ServiceBindingConditionServiceAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "ServiceNotFound",
+				Message: `service "my-cache": not found; service "my-db": no binding`,
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := &ServiceBindingStatus{Services: c.services}
+			actual.InitializeConditions(now)
+			actual.MarkServicesAvailable(now)
+
+			if diff := cmp.Diff(c.expected, actual.Conditions[1], cmpopts.IgnoreTypes(metav1.Time{})); diff != "" {
+				t.Errorf("MarkServicesAvailable() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestServiceBindingStatus_MarkServiceUnavailable(t *testing.T) {
 	now := metav1.Now()
 	expected := &ServiceBindingStatus{
@@ -507,6 +1443,88 @@ func TestServiceBindingStatus_MarkServiceUnavailable(t *testing.T) {
 	}
 }
 
+func TestServiceBindingStatus_MarkSecretValid(t *testing.T) {
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	tests := []struct {
+		name     string
+		seed     *ServiceBindingStatus
+		mark     func(*ServiceBindingStatus)
+		expected *ServiceBindingStatus
+	}{
+		{
+			name: "valid",
+			seed: &ServiceBindingStatus{},
+			mark: func(bs *ServiceBindingStatus) {
+				bs.MarkSecretValid("Valid", "", now)
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionTrue, LastTransitionTime: now, Reason: "Valid"},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionTrue, LastTransitionTime: earlier, Reason: "Valid"},
+				},
+			},
+			mark: func(bs *ServiceBindingStatus) {
+				bs.MarkSecretInvalid("MissingEntries", "the message", now)
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionFalse, LastTransitionTime: now, Reason: "MissingEntries", Message: "the message"},
+				},
+			},
+		},
+		{
+			name: "unchanged status",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionFalse, LastTransitionTime: earlier, Reason: "MissingType"},
+				},
+			},
+			mark: func(bs *ServiceBindingStatus) {
+				bs.MarkSecretInvalid("MissingEntries", "the message", now)
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionFalse, LastTransitionTime: earlier, Reason: "MissingEntries", Message: "the message"},
+				},
+			},
+		},
+		{
+			name: "clear",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionReady, Status: metav1.ConditionFalse, LastTransitionTime: earlier, Reason: "ServiceAvailableTheReason"},
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionFalse, LastTransitionTime: earlier, Reason: "MissingType"},
+				},
+			},
+			mark: func(bs *ServiceBindingStatus) {
+				bs.ClearSecretValid()
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionReady, Status: metav1.ConditionFalse, LastTransitionTime: earlier, Reason: "ServiceAvailableTheReason"},
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			c.mark(actual)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: MarkSecretValid() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
 func TestServiceBindingStatus_InitializeConditions(t *testing.T) {
 	now := metav1.Now()
 	tests := []struct {
@@ -560,6 +1578,74 @@ func TestServiceBindingStatus_InitializeConditions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "preserve containers matched",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionContainersMatched,
+						Status: metav1.ConditionFalse,
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionServiceAvailable, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionProjectionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionContainersMatched, Status: metav1.ConditionFalse},
+				},
+			},
+		},
+		{
+			name: "preserve secret valid",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionContainersMatched,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionSecretValid,
+						Status: metav1.ConditionFalse,
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionServiceAvailable, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionProjectionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionSecretValid, Status: metav1.ConditionFalse},
+					{Type: ServiceBindingConditionContainersMatched, Status: metav1.ConditionTrue},
+				},
+			},
+		},
+		{
+			name: "preserve unbound",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionUnbound,
+						Status: metav1.ConditionFalse,
+						Reason: "Unbinding",
+					},
+					{
+						Type:   ServiceBindingConditionContainersMatched,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{Type: ServiceBindingConditionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionServiceAvailable, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionProjectionReady, Status: metav1.ConditionUnknown, LastTransitionTime: now, Reason: "Unknown"},
+					{Type: ServiceBindingConditionContainersMatched, Status: metav1.ConditionTrue},
+					{Type: ServiceBindingConditionUnbound, Status: metav1.ConditionFalse, Reason: "Unbinding"},
+				},
+			},
+		},
 	}
 	for _, c := range tests {
 		t.Run(c.name, func(t *testing.T) {
@@ -790,6 +1876,95 @@ func TestServiceBindingStatus_aggregateReadyCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SecretValid False",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionServiceAvailable,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionProjectionReady,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:    ServiceBindingConditionSecretValid,
+						Status:  metav1.ConditionFalse,
+						Reason:  "TheReason",
+						Message: "the message",
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionFalse,
+						Reason:             "SecretValidTheReason",
+						Message:            "the message",
+						LastTransitionTime: now,
+					},
+					{
+						Type:   ServiceBindingConditionServiceAvailable,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionProjectionReady,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:    ServiceBindingConditionSecretValid,
+						Status:  metav1.ConditionFalse,
+						Reason:  "TheReason",
+						Message: "the message",
+					},
+				},
+			},
+		},
+		{
+			name: "SecretValid True",
+			seed: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   ServiceBindingConditionServiceAvailable,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionProjectionReady,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionSecretValid,
+						Status: metav1.ConditionTrue,
+						Reason: "Valid",
+					},
+				},
+			},
+			expected: &ServiceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               ServiceBindingConditionReady,
+						Status:             metav1.ConditionTrue,
+						Reason:             "Ready",
+						LastTransitionTime: now,
+					},
+					{
+						Type:   ServiceBindingConditionServiceAvailable,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionProjectionReady,
+						Status: metav1.ConditionTrue,
+					},
+					{
+						Type:   ServiceBindingConditionSecretValid,
+						Status: metav1.ConditionTrue,
+						Reason: "Valid",
+					},
+				},
+			},
+		},
 	}
 	for _, c := range tests {
 		t.Run(c.name, func(t *testing.T) {
@@ -8,15 +8,21 @@ package v1alpha3
 import (
 	"context"
 	"fmt"
+	"path"
 
+	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/jsonpath"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
 	"knative.dev/pkg/tracker"
 
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 )
 
@@ -41,6 +47,7 @@ var (
 	_ apis.Defaultable   = (*ServiceBinding)(nil)
 	_ kmeta.OwnerRefable = (*ServiceBinding)(nil)
 	_ duckv1.KRShaped    = (*ServiceBinding)(nil)
+	_ apis.Convertible   = (*ServiceBinding)(nil)
 )
 
 type ServiceBindingSpec struct {
@@ -59,16 +66,75 @@ type ServiceBindingSpec struct {
 	Workload *WorkloadReference `json:"workload,omitempty"`
 	// Service referencing the binding secret
 	Service *tracker.Reference `json:"service,omitempty"`
+	// Services are bound alongside Service, each as a binding of its own
+	// named after the service
+	// +optional
+	Services []BoundService `json:"services,omitempty"`
 
 	// Env projects keys from the binding secret into the workload as
 	// environment variables
 	Env []EnvVar `json:"env,omitempty"`
+
+	// Fields reads values from the service resource into a generated binding
+	// secret, for services that do not expose a binding secret of their own
+	// +optional
+	Fields []ServiceField `json:"fields,omitempty"`
+
+	// Mappings rename, drop or derive entries of the binding secret before
+	// it is projected into the workload
+	// +optional
+	Mappings []SecretMapping `json:"mappings,omitempty"`
+
+	// RolloutOnSecretChange rolls out the workload when the contents of the
+	// binding secret change, by annotating the workload's pod template with
+	// a hash of the secret. Environment variables projected from the secret
+	// are only refreshed by a rollout
+	// +optional
+	RolloutOnSecretChange bool `json:"rolloutOnSecretChange,omitempty"`
+
+	// MountPath is the absolute path the binding is mounted at in the
+	// workload's containers, instead of `$SERVICE_BINDING_ROOT/<name>`
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Class names the ServiceBindingClass in this namespace supplying
+	// defaults to the binding. Without a class, the defaults come from the
+	// class selecting the service by its labels, if any
+	// +optional
+	Class string `json:"class,omitempty"`
+}
+
+type BoundService struct {
+	// Name of the service's binding on disk
+	Name string `json:"name"`
+	// Type of the service. The value is exposed directly as the `type` in
+	// the service's mounted binding
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Provider of the service. The value is exposed directly as the
+	// `provider` in the service's mounted binding
+	// +optional
+	Provider string `json:"provider,omitempty"`
+	// Service referencing the binding secret, in the namespace of the
+	// ServiceBinding
+	Service *tracker.Reference `json:"service"`
+}
+
+type ServiceField struct {
+	// Key of the value in the generated binding secret
+	Key string `json:"key"`
+	// JSONPath of the value in the service resource, e.g. `.status.host`
+	JSONPath string `json:"jsonPath"`
 }
 
 type WorkloadReference = labsinternalv1alpha1.WorkloadReference
 
 type EnvVar = labsinternalv1alpha1.EnvVar
 
+type SecretMapping = labsinternalv1alpha1.SecretMapping
+
+type WorkloadsSummary = labsinternalv1alpha1.WorkloadsSummary
+
 type ServiceBindingStatus struct {
 	// ObservedGeneration is the 'Generation' of the ServiceBinding that
 	// was last processed by the controller.
@@ -84,6 +150,42 @@ type ServiceBindingStatus struct {
 	// Binding is a reference to the Secret being bound.
 	// +optional
 	Binding *corev1.LocalObjectReference `json:"binding,omitempty"`
+
+	// BindingKind is the kind of the resource referenced by Binding, either
+	// Secret or ConfigMap. Defaults to Secret.
+	// +optional
+	BindingKind string `json:"bindingKind,omitempty"`
+
+	// Services is the status of each of the services bound alongside the
+	// service, in the order of the spec
+	// +optional
+	Services []BoundServiceStatus `json:"services,omitempty"`
+
+	// Workloads summarizes the binding state of the workload resources
+	// matched by the workload reference.
+	// +optional
+	Workloads *WorkloadsSummary `json:"workloads,omitempty"`
+
+	// SecretHash is the hash of the binding secret's data last applied to
+	// the workloads, when RolloutOnSecretChange is set.
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+type BoundServiceStatus struct {
+	// Name of the service's binding on disk
+	Name string `json:"name"`
+	// Available is true when the binding secret of the service is resolved
+	Available bool `json:"available"`
+	// Binding is a reference to the Secret of the service being bound
+	// +optional
+	Binding *corev1.LocalObjectReference `json:"binding,omitempty"`
+	// Reason the service is not available
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message describes why the service is not available
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -124,14 +226,36 @@ func (b *ServiceBinding) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(
 			s.Validate(ctx).ViaField("spec.service"),
 		)
-		if b.Spec.Service.Namespace != "" {
+		if b.Spec.Service.Name == "" {
 			errs = errs.Also(
-				apis.ErrDisallowedFields("spec.service.namespace"),
+				apis.ErrMissingField("spec.service.name"),
 			)
 		}
-		if b.Spec.Service.Name == "" {
+	}
+
+	serviceSet := map[string][]int{}
+	for i, s := range b.Spec.Services {
+		errs = errs.Also(
+			s.Validate(ctx).ViaFieldIndex("services", i).ViaField("spec"),
+		)
+		serviceSet[s.Name] = append(serviceSet[s.Name], i)
+	}
+	// look for conflicting names, including the name of the binding
+	for name, v := range serviceSet {
+		if name == b.Spec.Name {
+			for _, i := range v {
+				errs = errs.Also(
+					apis.ErrInvalidValue(name, fmt.Sprintf("spec.services[%d].name", i)),
+				)
+			}
+		}
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.services[%d].name", i)
+			}
 			errs = errs.Also(
-				apis.ErrMissingField("spec.service.name"),
+				apis.ErrMultipleOneOf(paths...),
 			)
 		}
 	}
@@ -151,7 +275,47 @@ func (b *ServiceBinding) Validate(ctx context.Context) (errs *apis.FieldError) {
 		if len(v) != 1 {
 			paths := make([]string, len(v))
 			for pi, i := range v {
-				paths[i] = fmt.Sprintf("spec.env[%d].name", pi)
+				paths[pi] = fmt.Sprintf("spec.env[%d].name", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
+
+	fieldSet := map[string][]int{}
+	for i, f := range b.Spec.Fields {
+		errs = errs.Also(
+			f.Validate(ctx).ViaFieldIndex("fields", i).ViaField("spec"),
+		)
+		fieldSet[f.Key] = append(fieldSet[f.Key], i)
+	}
+	// look for conflicting keys
+	for _, v := range fieldSet {
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.fields[%d].key", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
+
+	mappingSet := map[string][]int{}
+	for i, m := range b.Spec.Mappings {
+		errs = errs.Also(
+			m.Validate(ctx).ViaFieldIndex("mappings", i).ViaField("spec"),
+		)
+		mappingSet[m.Key] = append(mappingSet[m.Key], i)
+	}
+	// look for conflicting keys
+	for _, v := range mappingSet {
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.mappings[%d].key", i)
 			}
 			errs = errs.Also(
 				apis.ErrMultipleOneOf(paths...),
@@ -159,13 +323,130 @@ func (b *ServiceBinding) Validate(ctx context.Context) (errs *apis.FieldError) {
 		}
 	}
 
+	if b.Spec.MountPath != "" && !path.IsAbs(b.Spec.MountPath) {
+		errs = errs.Also(
+			apis.ErrInvalidValue(b.Spec.MountPath, "spec.mountPath"),
+		)
+	}
+
+	if b.Spec.Class != "" {
+		if msgs := validation.IsDNS1123Subdomain(b.Spec.Class); len(msgs) != 0 {
+			errs = errs.Also(
+				apis.ErrInvalidValue(b.Spec.Class, "spec.class"),
+			)
+		} else if b.classChanged(ctx) {
+			if _, err := labsv1alpha1.FindServiceBindingClass(ctx, b.Namespace, b.Spec.Class, nil); err != nil {
+				errs = errs.Also(
+					&apis.FieldError{Message: err.Error(), Paths: []string{"spec.class"}},
+				)
+			}
+		}
+	}
+
 	return errs
 }
 
-func (b *ServiceBinding) SetDefaults(context.Context) {
+// classChanged returns false while the ServiceBinding is updated without
+// changing its class, so that a ServiceBinding is not stuck once its class is
+// deleted.
+func (b *ServiceBinding) classChanged(ctx context.Context) bool {
+	if !apis.IsInUpdate(ctx) {
+		return true
+	}
+	original, ok := apis.GetBaseline(ctx).(*ServiceBinding)
+	return !ok || original.Spec.Class != b.Spec.Class
+}
+
+func (s BoundService) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if s.Name == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("name"),
+		)
+	} else if msgs := validation.IsConfigMapKey(s.Name); len(msgs) != 0 {
+		errs = errs.Also(
+			apis.ErrInvalidValue(s.Name, "name"),
+		)
+	}
+	if s.Service == nil {
+		errs = errs.Also(
+			apis.ErrMissingField("service"),
+		)
+	} else {
+		// tracker.Reference requires a Namespace
+		r := s.Service.DeepCopy()
+		r.Namespace = "fake"
+		errs = errs.Also(
+			r.Validate(ctx).ViaField("service"),
+		)
+		if s.Service.Name == "" {
+			errs = errs.Also(
+				apis.ErrMissingField("service.name"),
+			)
+		}
+		if s.Service.Namespace != "" {
+			errs = errs.Also(
+				apis.ErrDisallowedFields("service.namespace"),
+			)
+		}
+	}
+
+	return errs
+}
+
+func (f ServiceField) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if f.Key == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("key"),
+		)
+	} else if msgs := validation.IsConfigMapKey(f.Key); len(msgs) != 0 {
+		errs = errs.Also(
+			apis.ErrInvalidValue(f.Key, "key"),
+		)
+	}
+	if f.JSONPath == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("jsonPath"),
+		)
+	} else if err := jsonpath.New("").Parse(fmt.Sprintf("{%s}", f.JSONPath)); err != nil {
+		errs = errs.Also(
+			apis.ErrInvalidValue(f.JSONPath, "jsonPath"),
+		)
+	}
+
+	return errs
+}
+
+func (b *ServiceBinding) SetDefaults(ctx context.Context) {
 	if b.Spec.Name == "" {
 		b.Spec.Name = b.Name
 	}
+	class, err := labsv1alpha1.FindServiceBindingClass(ctx, b.Namespace, b.Spec.Class, b.Spec.Service)
+	if err != nil {
+		// a missing named class is rejected by Validate
+		logging.FromContext(ctx).Warnw("Failed to find ServiceBindingClass", zap.Error(err))
+		return
+	}
+	if class != nil {
+		b.applyClass(class)
+	}
+}
+
+// applyClass sets the defaults supplied by the class for the fields the
+// ServiceBinding does not set.
+func (b *ServiceBinding) applyClass(class *labsv1alpha1.ServiceBindingClass) {
+	if b.Spec.Type == "" {
+		b.Spec.Type = class.Spec.Type
+	}
+	if b.Spec.Provider == "" {
+		b.Spec.Provider = class.Spec.Provider
+	}
+	b.Spec.Env = class.DefaultEnv(b.Spec.Env)
+	if b.Spec.Workload != nil && len(b.Spec.Workload.Containers) == 0 && len(class.Spec.Containers) != 0 {
+		b.Spec.Workload.Containers = append([]string{}, class.Spec.Containers...)
+	}
+	if b.Spec.MountPath == "" {
+		b.Spec.MountPath = class.Spec.MountPath
+	}
 }
 
 func (b *ServiceBinding) GetGroupVersionKind() schema.GroupVersionKind {
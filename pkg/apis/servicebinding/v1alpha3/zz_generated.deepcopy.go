@@ -18,6 +18,48 @@ import (
 	tracker "knative.dev/pkg/tracker"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BoundService) DeepCopyInto(out *BoundService) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(tracker.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BoundService.
+func (in *BoundService) DeepCopy() *BoundService {
+	if in == nil {
+		return nil
+	}
+	out := new(BoundService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BoundServiceStatus) DeepCopyInto(out *BoundServiceStatus) {
+	*out = *in
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BoundServiceStatus.
+func (in *BoundServiceStatus) DeepCopy() *BoundServiceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BoundServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
 	*out = *in
@@ -92,11 +134,28 @@ func (in *ServiceBindingSpec) DeepCopyInto(out *ServiceBindingSpec) {
 		*out = new(tracker.Reference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]BoundService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]v1alpha1.EnvVar, len(*in))
 		copy(*out, *in)
 	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]ServiceField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mappings != nil {
+		in, out := &in.Mappings, &out.Mappings
+		*out = make([]v1alpha1.SecretMapping, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -110,6 +169,22 @@ func (in *ServiceBindingSpec) DeepCopy() *ServiceBindingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceField) DeepCopyInto(out *ServiceField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceField.
+func (in *ServiceField) DeepCopy() *ServiceField {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 	*out = *in
@@ -125,6 +200,18 @@ func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]BoundServiceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = new(v1alpha1.WorkloadsSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
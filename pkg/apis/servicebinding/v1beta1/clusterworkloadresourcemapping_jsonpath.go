@@ -0,0 +1,299 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ExtractPodSpecable builds a PodSpecable view of the workload resource from
+// the fields referenced by the mapping template. The container-like fragments
+// matched by a mapping whose path ends with `initContainers` are exposed as
+// init containers, every other fragment is exposed as a container, each in the
+// order they are matched. The template must be defaulted.
+func (t *ClusterWorkloadResourceMappingTemplate) ExtractPodSpecable(workload *unstructured.Unstructured) (*duckv1.WithPod, error) {
+	ps := &duckv1.WithPod{}
+	ps.SetGroupVersionKind(workload.GroupVersionKind())
+	ps.Name = workload.GetName()
+	ps.Namespace = workload.GetNamespace()
+	ps.UID = workload.GetUID()
+	ps.Generation = workload.GetGeneration()
+	ps.Labels = workload.GetLabels()
+	ps.Annotations = workload.GetAnnotations()
+
+	annotationsPath, err := fixedJSONPath(t.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	annotations, _, err := unstructured.NestedStringMap(workload.Object, annotationsPath...)
+	if err != nil {
+		return nil, err
+	}
+	ps.Spec.Template.Annotations = annotations
+
+	containers, err := t.findContainers(workload)
+	if err != nil {
+		return nil, err
+	}
+	for _, mc := range containers {
+		c := corev1.Container{}
+		if mc.mapping.Name != "" {
+			namePath, err := fixedJSONPath(mc.mapping.Name)
+			if err != nil {
+				return nil, err
+			}
+			if c.Name, _, err = unstructured.NestedString(mc.fragment, namePath...); err != nil {
+				return nil, err
+			}
+		}
+		envPath, err := fixedJSONPath(mc.mapping.Env)
+		if err != nil {
+			return nil, err
+		}
+		if err := nestedTypedSlice(mc.fragment, &c.Env, envPath...); err != nil {
+			return nil, err
+		}
+		volumeMountsPath, err := fixedJSONPath(mc.mapping.VolumeMounts)
+		if err != nil {
+			return nil, err
+		}
+		if err := nestedTypedSlice(mc.fragment, &c.VolumeMounts, volumeMountsPath...); err != nil {
+			return nil, err
+		}
+		if mc.init {
+			ps.Spec.Template.Spec.InitContainers = append(ps.Spec.Template.Spec.InitContainers, c)
+		} else {
+			ps.Spec.Template.Spec.Containers = append(ps.Spec.Template.Spec.Containers, c)
+		}
+	}
+
+	volumesPath, err := fixedJSONPath(t.Volumes)
+	if err != nil {
+		return nil, err
+	}
+	if err := nestedTypedSlice(workload.Object, &ps.Spec.Template.Spec.Volumes, volumesPath...); err != nil {
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+// InjectPodSpecable writes the mapped fields of the PodSpecable back into the
+// workload resource. The PodSpecable must have been extracted from the same
+// workload with the same template. Only annotations, container environment
+// variables, container volume mounts and volumes are written.
+func (t *ClusterWorkloadResourceMappingTemplate) InjectPodSpecable(workload *unstructured.Unstructured, ps *duckv1.WithPod) error {
+	workload.SetAnnotations(ps.Annotations)
+
+	annotationsPath, err := fixedJSONPath(t.Annotations)
+	if err != nil {
+		return err
+	}
+	if len(ps.Spec.Template.Annotations) == 0 {
+		unstructured.RemoveNestedField(workload.Object, annotationsPath...)
+	} else if err := unstructured.SetNestedStringMap(workload.Object, ps.Spec.Template.Annotations, annotationsPath...); err != nil {
+		return err
+	}
+
+	containers, err := t.findContainers(workload)
+	if err != nil {
+		return err
+	}
+	initContainers := []mappedContainer{}
+	appContainers := []mappedContainer{}
+	for _, mc := range containers {
+		if mc.init {
+			initContainers = append(initContainers, mc)
+		} else {
+			appContainers = append(appContainers, mc)
+		}
+	}
+	if len(initContainers) != len(ps.Spec.Template.Spec.InitContainers) {
+		return fmt.Errorf("mapped init containers changed, expected %d found %d", len(ps.Spec.Template.Spec.InitContainers), len(initContainers))
+	}
+	if len(appContainers) != len(ps.Spec.Template.Spec.Containers) {
+		return fmt.Errorf("mapped containers changed, expected %d found %d", len(ps.Spec.Template.Spec.Containers), len(appContainers))
+	}
+	if err := injectContainers(initContainers, ps.Spec.Template.Spec.InitContainers); err != nil {
+		return err
+	}
+	if err := injectContainers(appContainers, ps.Spec.Template.Spec.Containers); err != nil {
+		return err
+	}
+
+	volumesPath, err := fixedJSONPath(t.Volumes)
+	if err != nil {
+		return err
+	}
+	return setNestedTypedSlice(workload.Object, ps.Spec.Template.Spec.Volumes, volumesPath...)
+}
+
+// injectContainers writes the environment variables and volume mounts of each
+// container into the container-like fragment at the same index.
+func injectContainers(mcs []mappedContainer, containers []corev1.Container) error {
+	for i, mc := range mcs {
+		c := containers[i]
+		envPath, err := fixedJSONPath(mc.mapping.Env)
+		if err != nil {
+			return err
+		}
+		if err := setNestedTypedSlice(mc.fragment, c.Env, envPath...); err != nil {
+			return err
+		}
+		volumeMountsPath, err := fixedJSONPath(mc.mapping.VolumeMounts)
+		if err != nil {
+			return err
+		}
+		if err := setNestedTypedSlice(mc.fragment, c.VolumeMounts, volumeMountsPath...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type mappedContainer struct {
+	mapping  ClusterWorkloadResourceMappingContainer
+	fragment map[string]interface{}
+	// init is true when the fragment is matched by a mapping for init
+	// containers
+	init bool
+}
+
+// findContainers returns the container-like fragments of the workload. The
+// fragments are references into the workload and may be mutated in place.
+func (t *ClusterWorkloadResourceMappingTemplate) findContainers(workload *unstructured.Unstructured) ([]mappedContainer, error) {
+	containers := []mappedContainer{}
+	for _, mapping := range t.Containers {
+		jp, err := parseJSONPath(mapping.Path)
+		if err != nil {
+			return nil, err
+		}
+		results, err := jp.FindResults(workload.Object)
+		if err != nil {
+			return nil, err
+		}
+		initContainers, err := isInitContainersPath(mapping.Path)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			for _, v := range result {
+				fragment, ok := v.Interface().(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("container path %q must reference an object, found %T", mapping.Path, v.Interface())
+				}
+				containers = append(containers, mappedContainer{
+					mapping:  mapping,
+					fragment: fragment,
+					init:     initContainers,
+				})
+			}
+		}
+	}
+	return containers, nil
+}
+
+// parseJSONPath parses a JSONPath expression in the form `.spec.template`,
+// the surrounding braces are implied.
+func parseJSONPath(path string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(fmt.Sprintf("{%s}", path)); err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
+
+// isInitContainersPath returns true when the last field referenced by the
+// container path is `initContainers`, like the default
+// `.spec.template.spec.initContainers[*]`.
+func isInitContainersPath(path string) (bool, error) {
+	parser, err := jsonpath.Parse("", fmt.Sprintf("{%s}", path))
+	if err != nil {
+		return false, err
+	}
+	last := ""
+	for _, list := range parser.Root.Nodes {
+		l, ok := list.(*jsonpath.ListNode)
+		if !ok {
+			continue
+		}
+		for _, node := range l.Nodes {
+			if field, ok := node.(*jsonpath.FieldNode); ok && field.Value != "" {
+				last = field.Value
+			}
+		}
+	}
+	return last == "initContainers", nil
+}
+
+// fixedJSONPath parses a restricted JSONPath expression into the fields it
+// references. A restricted JSONPath may only contain field references, no
+// wildcards, array indexes or filters.
+func fixedJSONPath(path string) ([]string, error) {
+	parser, err := jsonpath.Parse("", fmt.Sprintf("{%s}", path))
+	if err != nil {
+		return nil, err
+	}
+	if len(parser.Root.Nodes) != 1 || parser.Root.Nodes[0].Type() != jsonpath.NodeList {
+		return nil, fmt.Errorf("path must be a single expression")
+	}
+	fields := []string{}
+	for _, node := range parser.Root.Nodes[0].(*jsonpath.ListNode).Nodes {
+		field, ok := node.(*jsonpath.FieldNode)
+		if !ok {
+			return nil, fmt.Errorf("path must only reference fields, found %s", node.Type())
+		}
+		if field.Value == "" {
+			continue
+		}
+		fields = append(fields, field.Value)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("path must reference at least one field")
+	}
+	return fields, nil
+}
+
+// nestedTypedSlice converts the slice at the path into the typed slice pointed
+// to by out. A missing field leaves out unchanged.
+func nestedTypedSlice(obj map[string]interface{}, out interface{}, fields ...string) error {
+	s, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found || s == nil {
+		return err
+	}
+	if _, ok := s.([]interface{}); !ok {
+		return fmt.Errorf("field %v must be a slice, found %T", fields, s)
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// setNestedTypedSlice converts the typed slice into its unstructured form and
+// sets it at the path. An empty slice removes the field.
+func setNestedTypedSlice(obj map[string]interface{}, in interface{}, fields ...string) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	items := []interface{}{}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return nil
+	}
+	return unstructured.SetNestedSlice(obj, items, fields...)
+}
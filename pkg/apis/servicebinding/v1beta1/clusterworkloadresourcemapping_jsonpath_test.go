@@ -0,0 +1,472 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func cronJobTemplate() *ClusterWorkloadResourceMappingTemplate {
+	t := &ClusterWorkloadResourceMappingTemplate{
+		Version:     "*",
+		Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+		Containers: []ClusterWorkloadResourceMappingContainer{
+			{
+				Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+				Name: ".name",
+			},
+			{
+				Path: ".spec.jobTemplate.spec.template.spec.initContainers[*]",
+				Name: ".name",
+			},
+		},
+		Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+	}
+	t.SetDefaults(context.TODO())
+	return t
+}
+
+func cronJob(podSpec map[string]interface{}, annotations map[string]interface{}) *unstructured.Unstructured {
+	podTemplate := map[string]interface{}{
+		"spec": podSpec,
+	}
+	if annotations != nil {
+		podTemplate["metadata"] = map[string]interface{}{
+			"annotations": annotations,
+		}
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "batch/v1beta1",
+			"kind":       "CronJob",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-cronjob",
+			},
+			"spec": map[string]interface{}{
+				"schedule": "@hourly",
+				"jobTemplate": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"template": podTemplate,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestClusterWorkloadResourceMappingTemplate_ExtractPodSpecable(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    *ClusterWorkloadResourceMappingTemplate
+		seed        *unstructured.Unstructured
+		expected    *duckv1.WithPod
+		expectedErr bool
+	}{
+		{
+			name:     "empty",
+			template: cronJobTemplate(),
+			seed:     cronJob(map[string]interface{}{}, nil),
+			expected: &duckv1.WithPod{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "batch/v1beta1",
+					Kind:       "CronJob",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-cronjob",
+				},
+			},
+		},
+		{
+			name:     "containers, volumes and annotations",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":  "init",
+						"image": "busybox",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+						"env": []interface{}{
+							map[string]interface{}{"name": "FOO", "value": "bar"},
+						},
+						"volumeMounts": []interface{}{
+							map[string]interface{}{"name": "my-volume", "mountPath": "/data"},
+						},
+					},
+				},
+				"volumes": []interface{}{
+					map[string]interface{}{"name": "my-volume", "emptyDir": map[string]interface{}{}},
+				},
+			}, map[string]interface{}{
+				"my-annotation": "my-value",
+			}),
+			expected: &duckv1.WithPod{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "batch/v1beta1",
+					Kind:       "CronJob",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-cronjob",
+				},
+				Spec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"my-annotation": "my-value",
+							},
+						},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "app",
+									Env: []corev1.EnvVar{
+										{Name: "FOO", Value: "bar"},
+									},
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "my-volume", MountPath: "/data"},
+									},
+								},
+							},
+							InitContainers: []corev1.Container{
+								{
+									Name: "init",
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "my-volume",
+									VolumeSource: corev1.VolumeSource{
+										EmptyDir: &corev1.EmptyDirVolumeSource{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "container is not an object",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"containers": []interface{}{"app"},
+			}, nil),
+			expectedErr: true,
+		},
+		{
+			name:     "env is not a slice",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "app",
+						"env":  "FOO=bar",
+					},
+				},
+			}, nil),
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := c.template.ExtractPodSpecable(c.seed)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("ExtractPodSpecable() expected err %v, got %v", c.expectedErr, err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: ExtractPodSpecable() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestClusterWorkloadResourceMappingTemplate_InjectPodSpecable(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    *ClusterWorkloadResourceMappingTemplate
+		seed        *unstructured.Unstructured
+		mutation    func(ps *duckv1.WithPod)
+		expected    *unstructured.Unstructured
+		expectedErr bool
+	}{
+		{
+			name:     "no changes",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+					},
+				},
+			}, nil),
+			mutation: func(ps *duckv1.WithPod) {},
+			expected: cronJob(map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+					},
+				},
+			}, nil),
+		},
+		{
+			name:     "inject",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":  "init",
+						"image": "busybox",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+					},
+				},
+			}, nil),
+			mutation: func(ps *duckv1.WithPod) {
+				ps.Annotations = map[string]string{"my-annotation": "my-value"}
+				ps.Spec.Template.Annotations = map[string]string{"my-template-annotation": "my-value"}
+				for i := range ps.Spec.Template.Spec.Containers {
+					c := &ps.Spec.Template.Spec.Containers[i]
+					c.Env = append(c.Env, corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"})
+					c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: "my-volume", MountPath: "/bindings/my-binding"})
+				}
+				for i := range ps.Spec.Template.Spec.InitContainers {
+					c := &ps.Spec.Template.Spec.InitContainers[i]
+					c.Env = append(c.Env, corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"})
+					c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: "my-volume", MountPath: "/bindings/my-binding"})
+				}
+				ps.Spec.Template.Spec.Volumes = append(ps.Spec.Template.Spec.Volumes, corev1.Volume{
+					Name: "my-volume",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: "my-secret"},
+					},
+				})
+			},
+			expected: func() *unstructured.Unstructured {
+				u := cronJob(map[string]interface{}{
+					"initContainers": []interface{}{
+						map[string]interface{}{
+							"name":  "init",
+							"image": "busybox",
+							"env": []interface{}{
+								map[string]interface{}{"name": "SERVICE_BINDING_ROOT", "value": "/bindings"},
+							},
+							"volumeMounts": []interface{}{
+								map[string]interface{}{"name": "my-volume", "mountPath": "/bindings/my-binding"},
+							},
+						},
+					},
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "app",
+							"image": "my-app",
+							"env": []interface{}{
+								map[string]interface{}{"name": "SERVICE_BINDING_ROOT", "value": "/bindings"},
+							},
+							"volumeMounts": []interface{}{
+								map[string]interface{}{"name": "my-volume", "mountPath": "/bindings/my-binding"},
+							},
+						},
+					},
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "my-volume", "secret": map[string]interface{}{"secretName": "my-secret"}},
+					},
+				}, map[string]interface{}{
+					"my-template-annotation": "my-value",
+				})
+				u.SetAnnotations(map[string]string{"my-annotation": "my-value"})
+				return u
+			}(),
+		},
+		{
+			name:     "inject app containers only",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":  "init",
+						"image": "busybox",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+					},
+				},
+			}, nil),
+			mutation: func(ps *duckv1.WithPod) {
+				c := &ps.Spec.Template.Spec.Containers[0]
+				c.Env = append(c.Env, corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"})
+			},
+			expected: cronJob(map[string]interface{}{
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":  "init",
+						"image": "busybox",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+						"env": []interface{}{
+							map[string]interface{}{"name": "SERVICE_BINDING_ROOT", "value": "/bindings"},
+						},
+					},
+				},
+			}, nil),
+		},
+		{
+			name:     "inject init containers only",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":  "init",
+						"image": "busybox",
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+					},
+				},
+			}, nil),
+			mutation: func(ps *duckv1.WithPod) {
+				c := &ps.Spec.Template.Spec.InitContainers[0]
+				c.Env = append(c.Env, corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"})
+			},
+			expected: cronJob(map[string]interface{}{
+				"initContainers": []interface{}{
+					map[string]interface{}{
+						"name":  "init",
+						"image": "busybox",
+						"env": []interface{}{
+							map[string]interface{}{"name": "SERVICE_BINDING_ROOT", "value": "/bindings"},
+						},
+					},
+				},
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+					},
+				},
+			}, nil),
+		},
+		{
+			name:     "remove",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":  "app",
+						"image": "my-app",
+						"env": []interface{}{
+							map[string]interface{}{"name": "SERVICE_BINDING_ROOT", "value": "/bindings"},
+						},
+					},
+				},
+				"volumes": []interface{}{
+					map[string]interface{}{"name": "my-volume", "secret": map[string]interface{}{"secretName": "my-secret"}},
+				},
+			}, map[string]interface{}{
+				"my-template-annotation": "my-value",
+			}),
+			mutation: func(ps *duckv1.WithPod) {
+				ps.Spec.Template.Annotations = map[string]string{}
+				ps.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{}
+				ps.Spec.Template.Spec.Volumes = []corev1.Volume{}
+			},
+			expected: func() *unstructured.Unstructured {
+				u := cronJob(map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "app",
+							"image": "my-app",
+						},
+					},
+				}, nil)
+				u.Object["spec"].(map[string]interface{})["jobTemplate"].(map[string]interface{})["spec"].(map[string]interface{})["template"].(map[string]interface{})["metadata"] = map[string]interface{}{}
+				return u
+			}(),
+		},
+		{
+			name:     "containers changed",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "app",
+					},
+				},
+			}, nil),
+			mutation: func(ps *duckv1.WithPod) {
+				ps.Spec.Template.Spec.Containers = append(ps.Spec.Template.Spec.Containers, corev1.Container{Name: "sidecar"})
+			},
+			expectedErr: true,
+		},
+		{
+			name:     "init containers changed",
+			template: cronJobTemplate(),
+			seed: cronJob(map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name": "app",
+					},
+				},
+			}, nil),
+			mutation: func(ps *duckv1.WithPod) {
+				ps.Spec.Template.Spec.InitContainers = append(ps.Spec.Template.Spec.InitContainers, corev1.Container{Name: "init"})
+			},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ps, err := c.template.ExtractPodSpecable(c.seed)
+			if err != nil {
+				t.Fatalf("ExtractPodSpecable() unexpected err %v", err)
+			}
+			c.mutation(ps)
+			actual := c.seed.DeepCopy()
+			err = c.template.InjectPodSpecable(actual, ps)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("InjectPodSpecable() expected err %v, got %v", c.expectedErr, err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: InjectPodSpecable() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+)
+
+func TestClusterWorkloadResourceMapping_GetGroupVersionKind(t *testing.T) {
+	if got, want := (&ClusterWorkloadResourceMapping{}).GetGroupVersionKind().String(), "servicebinding.io/v1beta1, Kind=ClusterWorkloadResourceMapping"; got != want {
+		t.Errorf("GetGroupVersionKind() = %v, want %v", got, want)
+	}
+}
+
+func TestClusterWorkloadResourceMapping_SetDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterWorkloadResourceMapping
+		expected *ClusterWorkloadResourceMapping
+	}{
+		{
+			name:     "empty",
+			seed:     &ClusterWorkloadResourceMapping{},
+			expected: &ClusterWorkloadResourceMapping{},
+		},
+		{
+			name: "podspecable defaults",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{Version: "*"},
+					},
+				},
+			},
+			expected: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "*",
+							Annotations: ".spec.template.metadata.annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path:         ".spec.template.spec.containers[*]",
+									Name:         ".name",
+									Env:          ".env",
+									VolumeMounts: ".volumeMounts",
+								},
+								{
+									Path:         ".spec.template.spec.initContainers[*]",
+									Name:         ".name",
+									Env:          ".env",
+									VolumeMounts: ".volumeMounts",
+								},
+							},
+							Volumes: ".spec.template.spec.volumes",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "custom containers",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "v1",
+							Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+								},
+							},
+							Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+						},
+					},
+				},
+			},
+			expected: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "v1",
+							Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path:         ".spec.jobTemplate.spec.template.spec.containers[*]",
+									Env:          ".env",
+									VolumeMounts: ".volumeMounts",
+								},
+							},
+							Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.SetDefaults(context.TODO())
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: SetDefaults() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestClusterWorkloadResourceMapping_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ClusterWorkloadResourceMapping
+		expected *apis.FieldError
+	}{
+		{
+			name:     "empty",
+			seed:     &ClusterWorkloadResourceMapping{},
+			expected: nil,
+		},
+		{
+			name: "valid",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "*",
+							Annotations: ".spec.template.metadata.annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path:         ".spec.template.spec.containers[*]",
+									Name:         ".name",
+									Env:          ".env",
+									VolumeMounts: ".volumeMounts",
+								},
+							},
+							Volumes: ".spec.template.spec.volumes",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "missing version and path",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{},
+							},
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.versions[0].version"),
+				apis.ErrMissingField("spec.versions[0].containers[0].path"),
+			),
+		},
+		{
+			name: "duplicate versions",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{Version: "v1"},
+						{Version: "v1"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMultipleOneOf(
+					"spec.versions[0].version",
+					"spec.versions[1].version",
+				),
+			),
+		},
+		{
+			name: "invalid paths",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{
+						{
+							Version:     "*",
+							Annotations: ".spec.template..annotations",
+							Containers: []ClusterWorkloadResourceMappingContainer{
+								{
+									Path:         "{bad",
+									Name:         "name",
+									Env:          ".env[*]",
+									VolumeMounts: ".",
+								},
+							},
+							Volumes: ".spec.template.spec.volumes[0]",
+						},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				errInvalidJSONPath(".spec.template..annotations", "spec.versions[0].annotations", fmt.Errorf("path must only reference fields, found NodeRecursive")),
+				errInvalidJSONPath("{bad", "spec.versions[0].containers[0].path", fmt.Errorf("unrecognized character in action: U+007B '{'")),
+				errInvalidJSONPath("name", "spec.versions[0].containers[0].name", fmt.Errorf("path must only reference fields, found NodeIdentifier")),
+				errInvalidJSONPath(".env[*]", "spec.versions[0].containers[0].env", fmt.Errorf("path must only reference fields, found NodeArray")),
+				errInvalidJSONPath(".", "spec.versions[0].containers[0].volumeMounts", fmt.Errorf("path must reference at least one field")),
+				errInvalidJSONPath(".spec.template.spec.volumes[0]", "spec.versions[0].volumes", fmt.Errorf("path must only reference fields, found NodeArray")),
+			),
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.Validate(context.TODO())
+			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
+				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestClusterWorkloadResourceMapping_LookupTemplate(t *testing.T) {
+	v1 := ClusterWorkloadResourceMappingTemplate{Version: "v1", Volumes: ".v1"}
+	wildcard := ClusterWorkloadResourceMappingTemplate{Version: "*", Volumes: ".wildcard"}
+
+	tests := []struct {
+		name     string
+		seed     *ClusterWorkloadResourceMapping
+		version  string
+		expected *ClusterWorkloadResourceMappingTemplate
+	}{
+		{
+			name:     "empty",
+			seed:     &ClusterWorkloadResourceMapping{},
+			version:  "v1",
+			expected: nil,
+		},
+		{
+			name: "exact match",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{wildcard, v1},
+				},
+			},
+			version:  "v1",
+			expected: &v1,
+		},
+		{
+			name: "wildcard match",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{v1, wildcard},
+				},
+			},
+			version:  "v2",
+			expected: &wildcard,
+		},
+		{
+			name: "no match",
+			seed: &ClusterWorkloadResourceMapping{
+				Spec: ClusterWorkloadResourceMappingSpec{
+					Versions: []ClusterWorkloadResourceMappingTemplate{v1},
+				},
+			},
+			version:  "v2",
+			expected: nil,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.LookupTemplate(c.version)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: LookupTemplate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
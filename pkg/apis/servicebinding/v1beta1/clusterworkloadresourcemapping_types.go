@@ -0,0 +1,257 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// WildcardVersion matches any version of the mapped resource that is not
+	// otherwise explicitly mapped
+	WildcardVersion = "*"
+
+	defaultAnnotationsPath          = ".spec.template.metadata.annotations"
+	defaultContainersPath           = ".spec.template.spec.containers[*]"
+	defaultInitContainersPath       = ".spec.template.spec.initContainers[*]"
+	defaultContainerNamePath        = ".name"
+	defaultContainerEnvPath         = ".env"
+	defaultContainerVolumeMountPath = ".volumeMounts"
+	defaultVolumesPath              = ".spec.template.spec.volumes"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterWorkloadResourceMapping describes where the PodSpec-like fields of a
+// workload resource live. The resource name must be in the form
+// `<plural>.<group>` of the workload resource being mapped.
+type ClusterWorkloadResourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterWorkloadResourceMappingSpec `json:"spec,omitempty"`
+}
+
+var (
+	// Check that ClusterWorkloadResourceMapping can be validated and defaulted.
+	_ apis.Validatable = (*ClusterWorkloadResourceMapping)(nil)
+	_ apis.Defaultable = (*ClusterWorkloadResourceMapping)(nil)
+)
+
+type ClusterWorkloadResourceMappingSpec struct {
+	// Versions is the collection of versions for a given resource, with
+	// mappings
+	Versions []ClusterWorkloadResourceMappingTemplate `json:"versions,omitempty"`
+}
+
+type ClusterWorkloadResourceMappingTemplate struct {
+	// Version is the version of the workload resource that this mapping is
+	// for, or `*` to match any version not otherwise mapped
+	Version string `json:"version"`
+	// Annotations is a restricted JSONPath that references the annotations
+	// map within the workload resource. These annotations are used as the
+	// source of the downward API for the `type` and `provider` entries.
+	// Defaults to `.spec.template.metadata.annotations`
+	// +optional
+	Annotations string `json:"annotations,omitempty"`
+	// Containers is the collection of mappings to container-like fragments
+	// of the workload resource. Defaults to mappings appropriate for a
+	// PodSpecable resource (both containers and initContainers).
+	// +optional
+	Containers []ClusterWorkloadResourceMappingContainer `json:"containers,omitempty"`
+	// Volumes is a restricted JSONPath that references the slice of volumes
+	// within the workload resource. Defaults to `.spec.template.spec.volumes`
+	// +optional
+	Volumes string `json:"volumes,omitempty"`
+}
+
+type ClusterWorkloadResourceMappingContainer struct {
+	// Path is the JSONPath within the workload resource that matches an
+	// existing fragment that is container-like. The path may match multiple
+	// containers, for example `.spec.template.spec.containers[*]`. Fragments
+	// matched by a path whose last field is `initContainers` are bound as
+	// init containers
+	Path string `json:"path"`
+	// Name is a restricted JSONPath relative to the container that
+	// references the container name. Containers without a name mapping can
+	// not be targeted by name.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Env is a restricted JSONPath relative to the container that references
+	// the slice of environment variables. Defaults to `.env`
+	// +optional
+	Env string `json:"env,omitempty"`
+	// VolumeMounts is a restricted JSONPath relative to the container that
+	// references the slice of volume mounts. Defaults to `.volumeMounts`
+	// +optional
+	VolumeMounts string `json:"volumeMounts,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterWorkloadResourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterWorkloadResourceMapping `json:"items"`
+}
+
+func (m *ClusterWorkloadResourceMapping) Validate(ctx context.Context) (errs *apis.FieldError) {
+	versionSet := map[string][]int{}
+	for i, v := range m.Spec.Versions {
+		errs = errs.Also(
+			v.Validate(ctx).ViaFieldIndex("versions", i).ViaField("spec"),
+		)
+		versionSet[v.Version] = append(versionSet[v.Version], i)
+	}
+	// look for conflicting versions
+	for _, v := range versionSet {
+		if len(v) != 1 {
+			paths := make([]string, len(v))
+			for pi, i := range v {
+				paths[pi] = fmt.Sprintf("spec.versions[%d].version", i)
+			}
+			errs = errs.Also(
+				apis.ErrMultipleOneOf(paths...),
+			)
+		}
+	}
+
+	return errs
+}
+
+func (t *ClusterWorkloadResourceMappingTemplate) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if t.Version == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("version"),
+		)
+	}
+	if t.Annotations != "" {
+		if _, err := fixedJSONPath(t.Annotations); err != nil {
+			errs = errs.Also(
+				errInvalidJSONPath(t.Annotations, "annotations", err),
+			)
+		}
+	}
+	for i, c := range t.Containers {
+		errs = errs.Also(
+			c.Validate(ctx).ViaFieldIndex("containers", i),
+		)
+	}
+	if t.Volumes != "" {
+		if _, err := fixedJSONPath(t.Volumes); err != nil {
+			errs = errs.Also(
+				errInvalidJSONPath(t.Volumes, "volumes", err),
+			)
+		}
+	}
+
+	return errs
+}
+
+func (c *ClusterWorkloadResourceMappingContainer) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if c.Path == "" {
+		errs = errs.Also(
+			apis.ErrMissingField("path"),
+		)
+	} else if _, err := parseJSONPath(c.Path); err != nil {
+		errs = errs.Also(
+			errInvalidJSONPath(c.Path, "path", err),
+		)
+	}
+	if c.Name != "" {
+		if _, err := fixedJSONPath(c.Name); err != nil {
+			errs = errs.Also(
+				errInvalidJSONPath(c.Name, "name", err),
+			)
+		}
+	}
+	if c.Env != "" {
+		if _, err := fixedJSONPath(c.Env); err != nil {
+			errs = errs.Also(
+				errInvalidJSONPath(c.Env, "env", err),
+			)
+		}
+	}
+	if c.VolumeMounts != "" {
+		if _, err := fixedJSONPath(c.VolumeMounts); err != nil {
+			errs = errs.Also(
+				errInvalidJSONPath(c.VolumeMounts, "volumeMounts", err),
+			)
+		}
+	}
+
+	return errs
+}
+
+func errInvalidJSONPath(value, field string, err error) *apis.FieldError {
+	fe := apis.ErrInvalidValue(value, field)
+	fe.Details = err.Error()
+	return fe
+}
+
+func (m *ClusterWorkloadResourceMapping) SetDefaults(ctx context.Context) {
+	for i := range m.Spec.Versions {
+		m.Spec.Versions[i].SetDefaults(ctx)
+	}
+}
+
+func (t *ClusterWorkloadResourceMappingTemplate) SetDefaults(context.Context) {
+	if t.Annotations == "" {
+		t.Annotations = defaultAnnotationsPath
+	}
+	if len(t.Containers) == 0 {
+		t.Containers = []ClusterWorkloadResourceMappingContainer{
+			{
+				Path: defaultContainersPath,
+				Name: defaultContainerNamePath,
+			},
+			{
+				Path: defaultInitContainersPath,
+				Name: defaultContainerNamePath,
+			},
+		}
+	}
+	for i := range t.Containers {
+		c := &t.Containers[i]
+		if c.Env == "" {
+			c.Env = defaultContainerEnvPath
+		}
+		if c.VolumeMounts == "" {
+			c.VolumeMounts = defaultContainerVolumeMountPath
+		}
+	}
+	if t.Volumes == "" {
+		t.Volumes = defaultVolumesPath
+	}
+}
+
+// LookupTemplate returns the mapping template for the version of the workload
+// resource, falling back to the wildcard version. Nil is returned if the
+// version is not mapped.
+func (m *ClusterWorkloadResourceMapping) LookupTemplate(version string) *ClusterWorkloadResourceMappingTemplate {
+	var wildcard *ClusterWorkloadResourceMappingTemplate
+	for i := range m.Spec.Versions {
+		t := &m.Spec.Versions[i]
+		if t.Version == version {
+			return t
+		}
+		if t.Version == WildcardVersion {
+			wildcard = t
+		}
+	}
+	return wildcard
+}
+
+func (m *ClusterWorkloadResourceMapping) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ClusterWorkloadResourceMapping")
+}
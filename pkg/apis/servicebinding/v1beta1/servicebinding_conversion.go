@@ -0,0 +1,199 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+)
+
+// ConvertTo implements apis.Convertible
+func (source *ServiceBinding) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1alpha3.ServiceBinding:
+		sink.ObjectMeta = *source.ObjectMeta.DeepCopy()
+		source.Spec.ConvertTo(ctx, &sink.Spec)
+		source.Status.ConvertTo(ctx, &sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertTo helps implement apis.Convertible
+func (source *ServiceBindingSpec) ConvertTo(ctx context.Context, sink *v1alpha3.ServiceBindingSpec) {
+	sink.Name = source.Name
+	sink.Type = source.Type
+	sink.Provider = source.Provider
+	sink.Workload = source.Workload.DeepCopy()
+	sink.Service = source.Service.DeepCopy()
+	sink.Services = nil
+	if source.Services != nil {
+		sink.Services = make([]v1alpha3.BoundService, len(source.Services))
+		for i := range source.Services {
+			sink.Services[i] = v1alpha3.BoundService{
+				Name:     source.Services[i].Name,
+				Type:     source.Services[i].Type,
+				Provider: source.Services[i].Provider,
+				Service:  source.Services[i].Service.DeepCopy(),
+			}
+		}
+	}
+	sink.Env = nil
+	if source.Env != nil {
+		sink.Env = make([]v1alpha3.EnvVar, len(source.Env))
+		copy(sink.Env, source.Env)
+	}
+	sink.Fields = nil
+	if source.Fields != nil {
+		sink.Fields = make([]v1alpha3.ServiceField, len(source.Fields))
+		for i := range source.Fields {
+			sink.Fields[i] = v1alpha3.ServiceField{
+				Key:      source.Fields[i].Key,
+				JSONPath: source.Fields[i].JSONPath,
+			}
+		}
+	}
+	sink.Mappings = nil
+	if source.Mappings != nil {
+		sink.Mappings = make([]v1alpha3.SecretMapping, len(source.Mappings))
+		copy(sink.Mappings, source.Mappings)
+	}
+	sink.RolloutOnSecretChange = source.RolloutOnSecretChange
+	sink.MountPath = source.MountPath
+	sink.Class = source.Class
+}
+
+// ConvertTo helps implement apis.Convertible
+func (source *ServiceBindingStatus) ConvertTo(ctx context.Context, sink *v1alpha3.ServiceBindingStatus) {
+	sink.ObservedGeneration = source.ObservedGeneration
+	sink.Conditions = nil
+	if source.Conditions != nil {
+		sink.Conditions = make([]metav1.Condition, len(source.Conditions))
+		for i := range source.Conditions {
+			source.Conditions[i].DeepCopyInto(&sink.Conditions[i])
+		}
+	}
+	sink.Binding = nil
+	if source.Binding != nil {
+		sink.Binding = &corev1.LocalObjectReference{Name: source.Binding.Name}
+	}
+	sink.BindingKind = source.BindingKind
+	sink.Services = nil
+	if source.Services != nil {
+		sink.Services = make([]v1alpha3.BoundServiceStatus, len(source.Services))
+		for i := range source.Services {
+			sink.Services[i] = v1alpha3.BoundServiceStatus{
+				Name:      source.Services[i].Name,
+				Available: source.Services[i].Available,
+				Reason:    source.Services[i].Reason,
+				Message:   source.Services[i].Message,
+			}
+			if source.Services[i].Binding != nil {
+				sink.Services[i].Binding = &corev1.LocalObjectReference{Name: source.Services[i].Binding.Name}
+			}
+		}
+	}
+	sink.Workloads = source.Workloads.DeepCopy()
+	sink.SecretHash = source.SecretHash
+}
+
+// ConvertFrom implements apis.Convertible
+func (sink *ServiceBinding) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1alpha3.ServiceBinding:
+		sink.ObjectMeta = *source.ObjectMeta.DeepCopy()
+		sink.Spec.ConvertFrom(ctx, &source.Spec)
+		sink.Status.ConvertFrom(ctx, &source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// ConvertFrom helps implement apis.Convertible
+func (sink *ServiceBindingSpec) ConvertFrom(ctx context.Context, source *v1alpha3.ServiceBindingSpec) {
+	sink.Name = source.Name
+	sink.Type = source.Type
+	sink.Provider = source.Provider
+	sink.Workload = source.Workload.DeepCopy()
+	sink.Service = source.Service.DeepCopy()
+	sink.Services = nil
+	if source.Services != nil {
+		sink.Services = make([]BoundService, len(source.Services))
+		for i := range source.Services {
+			sink.Services[i] = BoundService{
+				Name:     source.Services[i].Name,
+				Type:     source.Services[i].Type,
+				Provider: source.Services[i].Provider,
+				Service:  source.Services[i].Service.DeepCopy(),
+			}
+		}
+	}
+	sink.Env = nil
+	if source.Env != nil {
+		sink.Env = make([]EnvVar, len(source.Env))
+		copy(sink.Env, source.Env)
+	}
+	sink.Fields = nil
+	if source.Fields != nil {
+		sink.Fields = make([]ServiceField, len(source.Fields))
+		for i := range source.Fields {
+			sink.Fields[i] = ServiceField{
+				Key:      source.Fields[i].Key,
+				JSONPath: source.Fields[i].JSONPath,
+			}
+		}
+	}
+	sink.Mappings = nil
+	if source.Mappings != nil {
+		sink.Mappings = make([]SecretMapping, len(source.Mappings))
+		copy(sink.Mappings, source.Mappings)
+	}
+	sink.RolloutOnSecretChange = source.RolloutOnSecretChange
+	sink.MountPath = source.MountPath
+	sink.Class = source.Class
+}
+
+// ConvertFrom helps implement apis.Convertible
+func (sink *ServiceBindingStatus) ConvertFrom(ctx context.Context, source *v1alpha3.ServiceBindingStatus) {
+	sink.ObservedGeneration = source.ObservedGeneration
+	sink.Conditions = nil
+	if source.Conditions != nil {
+		sink.Conditions = make([]metav1.Condition, len(source.Conditions))
+		for i := range source.Conditions {
+			source.Conditions[i].DeepCopyInto(&sink.Conditions[i])
+		}
+	}
+	sink.Binding = nil
+	if source.Binding != nil {
+		sink.Binding = &corev1.LocalObjectReference{Name: source.Binding.Name}
+	}
+	sink.BindingKind = source.BindingKind
+	sink.Services = nil
+	if source.Services != nil {
+		sink.Services = make([]BoundServiceStatus, len(source.Services))
+		for i := range source.Services {
+			sink.Services[i] = BoundServiceStatus{
+				Name:      source.Services[i].Name,
+				Available: source.Services[i].Available,
+				Reason:    source.Services[i].Reason,
+				Message:   source.Services[i].Message,
+			}
+			if source.Services[i].Binding != nil {
+				sink.Services[i].Binding = &corev1.LocalObjectReference{Name: source.Services[i].Binding.Name}
+			}
+		}
+	}
+	sink.Workloads = source.Workloads.DeepCopy()
+	sink.SecretHash = source.SecretHash
+}
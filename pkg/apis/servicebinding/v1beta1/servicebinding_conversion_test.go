@@ -0,0 +1,160 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/tracker"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+)
+
+func TestServiceBinding_Conversion(t *testing.T) {
+	now := metav1.Now()
+	tests := []struct {
+		name string
+		in   *ServiceBinding
+	}{
+		{
+			name: "empty",
+			in:   &ServiceBinding{},
+		},
+		{
+			name: "full",
+			in: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "my-namespace",
+					Name:       "my-binding",
+					Generation: 2,
+					Labels: map[string]string{
+						"app": "my-app",
+					},
+				},
+				Spec: ServiceBindingSpec{
+					Name:     "my-name",
+					Type:     "my-type",
+					Provider: "my-provider",
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"app": "my-app",
+								},
+							},
+						},
+						Containers: []string{"my-container"},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Services: []BoundService{
+						{
+							Name:     "my-cache",
+							Type:     "redis",
+							Provider: "my-provider",
+							Service: &tracker.Reference{
+								APIVersion: "v1",
+								Kind:       "Secret",
+								Name:       "my-cache-secret",
+							},
+						},
+					},
+					Env: []EnvVar{
+						{Name: "MY_VAR", Key: "my-key"},
+					},
+					Fields: []ServiceField{
+						{Key: "host", JSONPath: ".status.host"},
+					},
+					Mappings: []SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
+					RolloutOnSecretChange: true,
+					MountPath:             "/etc/db",
+					Class:                 "database",
+				},
+				Status: ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Conditions: []metav1.Condition{
+						{Type: v1alpha3.ServiceBindingConditionReady, Status: metav1.ConditionTrue, Reason: "Ready", LastTransitionTime: now},
+						{Type: v1alpha3.ServiceBindingConditionServiceAvailable, Status: metav1.ConditionTrue, Reason: "Available", LastTransitionTime: now},
+						{Type: v1alpha3.ServiceBindingConditionProjectionReady, Status: metav1.ConditionTrue, Reason: "Projected", LastTransitionTime: now},
+					},
+					Binding: &corev1.LocalObjectReference{
+						Name: "my-secret",
+					},
+					BindingKind: "Secret",
+					Services: []BoundServiceStatus{
+						{
+							Name:      "my-cache",
+							Available: true,
+							Binding: &corev1.LocalObjectReference{
+								Name: "my-cache-secret",
+							},
+						},
+						{
+							Name:    "my-queue",
+							Reason:  "ServiceNotFound",
+							Message: "not found",
+						},
+					},
+					Workloads: &WorkloadsSummary{
+						Matched:  2,
+						Injected: 1,
+						Failed:   []string{"my-workload"},
+					},
+					SecretHash: "my-hash",
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			hub := &v1alpha3.ServiceBinding{}
+			if err := c.in.ConvertTo(context.TODO(), hub); err != nil {
+				t.Errorf("%s: ConvertTo() unexpected error: %v", c.name, err)
+			}
+			actual := &ServiceBinding{}
+			if err := actual.ConvertFrom(context.TODO(), hub); err != nil {
+				t.Errorf("%s: ConvertFrom() unexpected error: %v", c.name, err)
+			}
+			if diff := cmp.Diff(c.in, actual); diff != "" {
+				t.Errorf("%s: round trip (-expected, +actual): %s", c.name, diff)
+			}
+
+			// the hub delegates to this version
+			hub = &v1alpha3.ServiceBinding{}
+			if err := hub.ConvertFrom(context.TODO(), c.in); err != nil {
+				t.Errorf("%s: hub ConvertFrom() unexpected error: %v", c.name, err)
+			}
+			actual = &ServiceBinding{}
+			if err := hub.ConvertTo(context.TODO(), actual); err != nil {
+				t.Errorf("%s: hub ConvertTo() unexpected error: %v", c.name, err)
+			}
+			if diff := cmp.Diff(c.in, actual); diff != "" {
+				t.Errorf("%s: hub round trip (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+func TestServiceBinding_ConversionUnknownVersion(t *testing.T) {
+	in := &ServiceBinding{}
+	if err := in.ConvertTo(context.TODO(), &ServiceBinding{}); err == nil {
+		t.Errorf("ConvertTo() expected error")
+	}
+	if err := in.ConvertFrom(context.TODO(), &ServiceBinding{}); err == nil {
+		t.Errorf("ConvertFrom() expected error")
+	}
+}
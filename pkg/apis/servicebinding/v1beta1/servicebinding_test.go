@@ -0,0 +1,216 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/tracker"
+
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
+)
+
+func TestServiceBinding_GetGroupVersionKind(t *testing.T) {
+	if got, want := (&ServiceBinding{}).GetGroupVersionKind().String(), "servicebinding.io/v1beta1, Kind=ServiceBinding"; got != want {
+		t.Errorf("GetGroupVersionKind() = %v, want %v", got, want)
+	}
+}
+
+// The semantics of the ServiceBinding are implemented and tested by the hub
+// version, these tests only cover the delegation to it.
+
+func TestServiceBinding_SetDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		seed     *ServiceBinding
+		expected *ServiceBinding
+	}{
+		{
+			name: "empty",
+			seed: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+			},
+			expected: &ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingSpec{
+					Name: "my-binding",
+				},
+			},
+		},
+		{
+			name: "keeps type meta",
+			seed: &ServiceBinding{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "servicebinding.io/v1beta1",
+					Kind:       "ServiceBinding",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingSpec{
+					Name: "my-name",
+				},
+			},
+			expected: &ServiceBinding{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "servicebinding.io/v1beta1",
+					Kind:       "ServiceBinding",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-binding",
+				},
+				Spec: ServiceBindingSpec{
+					Name: "my-name",
+				},
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			actual.SetDefaults(context.TODO())
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: SetDefaults() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
+
+type noServiceBindingClasses struct{}
+
+func (noServiceBindingClasses) List(labels.Selector) ([]*labsv1alpha1.ServiceBindingClass, error) {
+	return nil, nil
+}
+
+func (noServiceBindingClasses) Get(name string) (*labsv1alpha1.ServiceBindingClass, error) {
+	return nil, apierrs.NewNotFound(labsv1alpha1.Resource("servicebindingclasses"), name)
+}
+
+func TestServiceBinding_Validate(t *testing.T) {
+	classed := &ServiceBinding{
+		Spec: ServiceBindingSpec{
+			Workload: &WorkloadReference{
+				Reference: tracker.Reference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "my-app",
+				},
+			},
+			Service: &tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Name:       "my-service",
+			},
+			Class: "my-class",
+		},
+	}
+	classes := labsv1alpha1.WithServiceBindingClasses(context.TODO(), func(string) labsv1alpha1.ServiceBindingClassNamespaceLister {
+		return noServiceBindingClasses{}
+	}, nil)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		seed     *ServiceBinding
+		expected *apis.FieldError
+	}{
+		{
+			name: "valid",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "duplicate env",
+			seed: &ServiceBinding{
+				Spec: ServiceBindingSpec{
+					Workload: &WorkloadReference{
+						Reference: tracker.Reference{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "my-app",
+						},
+					},
+					Service: &tracker.Reference{
+						APIVersion: "bindings.labs.vmware.com/v1alpha1",
+						Kind:       "ProvisionedService",
+						Name:       "my-service",
+					},
+					Env: []EnvVar{
+						{Name: "MY_OTHER_VAR", Key: "my-key0"},
+						{Name: "MY_VAR", Key: "my-key1"},
+						{Name: "MY_VAR", Key: "my-key2"},
+					},
+				},
+			},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMultipleOneOf(
+					"spec.env[1].name",
+					"spec.env[2].name",
+				),
+			),
+		},
+		{
+			name: "empty",
+			seed: &ServiceBinding{},
+			expected: (&apis.FieldError{}).Also(
+				apis.ErrMissingField("spec.workload"),
+				apis.ErrMissingField("spec.service"),
+			),
+		},
+		{
+			name: "missing class",
+			ctx:  apis.WithinCreate(classes),
+			seed: classed.DeepCopy(),
+			expected: &apis.FieldError{
+				Message: `failed to get ServiceBindingClass "my-class": servicebindingclasses.bindings.labs.vmware.com "my-class" not found`,
+				Paths:   []string{"spec.class"},
+			},
+		},
+		{
+			name:     "missing class unchanged on update",
+			ctx:      apis.WithinUpdate(classes, classed.DeepCopy()),
+			seed:     classed.DeepCopy(),
+			expected: nil,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := c.ctx
+			if ctx == nil {
+				ctx = context.TODO()
+			}
+			actual := c.seed.Validate(ctx)
+			if diff := cmp.Diff(c.expected.Error(), actual.Error()); diff != "" {
+				t.Errorf("%s: Validate() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
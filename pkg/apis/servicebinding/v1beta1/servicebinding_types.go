@@ -0,0 +1,224 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/tracker"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	"github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceBinding is served as v1beta1 and stored as the v1alpha3 hub version,
+// which implements its semantics. Both versions share the same fields.
+type ServiceBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceBindingSpec   `json:"spec,omitempty"`
+	Status ServiceBindingStatus `json:"status,omitempty"`
+}
+
+var (
+	// Check that ServiceBinding can be validated and defaulted.
+	_ apis.Validatable   = (*ServiceBinding)(nil)
+	_ apis.Defaultable   = (*ServiceBinding)(nil)
+	_ kmeta.OwnerRefable = (*ServiceBinding)(nil)
+	_ apis.Convertible   = (*ServiceBinding)(nil)
+)
+
+type ServiceBindingSpec struct {
+	// Name of the service binding on disk, defaults to this resource's name
+	Name string `json:"name,omitempty"`
+	// Type of the provisioned service. The value is exposed directly as the
+	// `type` in the mounted binding
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Provider of the provisioned service. The value is exposed directly as the
+	// `provider` in the mounted binding
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// Workload resource to inject the binding into
+	Workload *WorkloadReference `json:"workload,omitempty"`
+	// Service referencing the binding secret
+	Service *tracker.Reference `json:"service,omitempty"`
+	// Services are bound alongside Service, each as a binding of its own
+	// named after the service
+	// +optional
+	Services []BoundService `json:"services,omitempty"`
+
+	// Env projects keys from the binding secret into the workload as
+	// environment variables
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Fields reads values from the service resource into a generated binding
+	// secret, for services that do not expose a binding secret of their own
+	// +optional
+	Fields []ServiceField `json:"fields,omitempty"`
+
+	// Mappings rename, drop or derive entries of the binding secret before
+	// it is projected into the workload
+	// +optional
+	Mappings []SecretMapping `json:"mappings,omitempty"`
+
+	// RolloutOnSecretChange rolls out the workload when the contents of the
+	// binding secret change, by annotating the workload's pod template with
+	// a hash of the secret. Environment variables projected from the secret
+	// are only refreshed by a rollout
+	// +optional
+	RolloutOnSecretChange bool `json:"rolloutOnSecretChange,omitempty"`
+
+	// MountPath is the absolute path the binding is mounted at in the
+	// workload's containers, instead of `$SERVICE_BINDING_ROOT/<name>`
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// Class names the ServiceBindingClass in this namespace supplying
+	// defaults to the binding. Without a class, the defaults come from the
+	// class selecting the service by its labels, if any
+	// +optional
+	Class string `json:"class,omitempty"`
+}
+
+type BoundService struct {
+	// Name of the service's binding on disk
+	Name string `json:"name"`
+	// Type of the service. The value is exposed directly as the `type` in
+	// the service's mounted binding
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Provider of the service. The value is exposed directly as the
+	// `provider` in the service's mounted binding
+	// +optional
+	Provider string `json:"provider,omitempty"`
+	// Service referencing the binding secret, in the namespace of the
+	// ServiceBinding
+	Service *tracker.Reference `json:"service"`
+}
+
+type ServiceField struct {
+	// Key of the value in the generated binding secret
+	Key string `json:"key"`
+	// JSONPath of the value in the service resource, e.g. `.status.host`
+	JSONPath string `json:"jsonPath"`
+}
+
+type WorkloadReference = labsinternalv1alpha1.WorkloadReference
+
+type EnvVar = labsinternalv1alpha1.EnvVar
+
+type SecretMapping = labsinternalv1alpha1.SecretMapping
+
+type WorkloadsSummary = labsinternalv1alpha1.WorkloadsSummary
+
+type ServiceBindingStatus struct {
+	// ObservedGeneration is the 'Generation' of the ServiceBinding that
+	// was last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions the latest available observations of a ServiceBinding's current state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Binding is a reference to the Secret being bound.
+	// +optional
+	Binding *corev1.LocalObjectReference `json:"binding,omitempty"`
+
+	// BindingKind is the kind of the resource referenced by Binding, either
+	// Secret or ConfigMap. Defaults to Secret.
+	// +optional
+	BindingKind string `json:"bindingKind,omitempty"`
+
+	// Services is the status of each of the services bound alongside the
+	// service, in the order of the spec
+	// +optional
+	Services []BoundServiceStatus `json:"services,omitempty"`
+
+	// Workloads summarizes the binding state of the workload resources
+	// matched by the workload reference.
+	// +optional
+	Workloads *WorkloadsSummary `json:"workloads,omitempty"`
+
+	// SecretHash is the hash of the binding secret's data last applied to
+	// the workloads, when RolloutOnSecretChange is set.
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+}
+
+type BoundServiceStatus struct {
+	// Name of the service's binding on disk
+	Name string `json:"name"`
+	// Available is true when the binding secret of the service is resolved
+	Available bool `json:"available"`
+	// Binding is a reference to the Secret of the service being bound
+	// +optional
+	Binding *corev1.LocalObjectReference `json:"binding,omitempty"`
+	// Reason the service is not available
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message describes why the service is not available
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceBinding `json:"items"`
+}
+
+// Validate implements apis.Validatable by validating the ServiceBinding as
+// the hub version. The original of an update is validated against as the hub
+// version as well.
+func (b *ServiceBinding) Validate(ctx context.Context) *apis.FieldError {
+	hub := &v1alpha3.ServiceBinding{}
+	if err := b.ConvertTo(ctx, hub); err != nil {
+		return &apis.FieldError{Message: err.Error()}
+	}
+	if original, ok := apis.GetBaseline(ctx).(*ServiceBinding); ok && apis.IsInUpdate(ctx) {
+		originalHub := &v1alpha3.ServiceBinding{}
+		if err := original.ConvertTo(ctx, originalHub); err != nil {
+			return &apis.FieldError{Message: err.Error()}
+		}
+		if apis.IsInStatusUpdate(ctx) {
+			ctx = apis.WithinSubResourceUpdate(ctx, originalHub, "status")
+		} else {
+			ctx = apis.WithinUpdate(ctx, originalHub)
+		}
+	}
+	return hub.Validate(ctx)
+}
+
+// SetDefaults implements apis.Defaultable by defaulting the ServiceBinding as
+// the hub version.
+func (b *ServiceBinding) SetDefaults(ctx context.Context) {
+	hub := &v1alpha3.ServiceBinding{}
+	if err := b.ConvertTo(ctx, hub); err != nil {
+		return
+	}
+	hub.SetDefaults(ctx)
+	_ = b.ConvertFrom(ctx, hub)
+}
+
+func (b *ServiceBinding) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ServiceBinding")
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ServiceBindingRootEnv is the environment variable holding the
+	// directory the bindings are mounted under.
+	ServiceBindingRootEnv = "SERVICE_BINDING_ROOT"
+	// TypeKey is the entry of a binding holding its type.
+	TypeKey = "type"
+	// ProviderKey is the entry of a binding holding its provider.
+	ProviderKey = "provider"
+)
+
+// ErrRootNotSet is returned when SERVICE_BINDING_ROOT is not set, which
+// happens when no binding is projected into the workload.
+var ErrRootNotSet = errors.New(ServiceBindingRootEnv + " is not set")
+
+// Binding is a binding read from its directory.
+type Binding struct {
+	// Name of the binding, the name of its directory
+	Name string
+	// Path of the directory of the binding
+	Path string
+
+	entries map[string][]byte
+}
+
+// NewBinding creates a Binding holding a copy of the entries, for example to
+// test code consuming bindings without writing them to disk.
+func NewBinding(name string, entries map[string][]byte) *Binding {
+	b := &Binding{Name: name, entries: map[string][]byte{}}
+	for k, v := range entries {
+		b.entries[k] = append([]byte(nil), v...)
+	}
+	return b
+}
+
+// Type returns the type of the binding, trimmed of surrounding whitespace.
+func (b *Binding) Type() string {
+	return strings.TrimSpace(b.String(TypeKey))
+}
+
+// Provider returns the provider of the binding, trimmed of surrounding
+// whitespace, empty when it has none.
+func (b *Binding) Provider() string {
+	return strings.TrimSpace(b.String(ProviderKey))
+}
+
+// Keys returns the sorted keys of the entries of the binding.
+func (b *Binding) Keys() []string {
+	keys := make([]string, 0, len(b.entries))
+	for k := range b.entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Lookup returns the value of the entry and whether the binding has it.
+func (b *Binding) Lookup(key string) (string, bool) {
+	v, ok := b.entries[key]
+	return string(v), ok
+}
+
+// String returns the value of the entry, empty when the binding does not
+// have it.
+func (b *Binding) String(key string) string {
+	v, _ := b.Lookup(key)
+	return v
+}
+
+// Bytes returns the raw value of the entry, nil when the binding does not
+// have it.
+func (b *Binding) Bytes(key string) []byte {
+	return b.entries[key]
+}
+
+// Int parses the value of the entry as an integer.
+func (b *Binding) Int(key string) (int, error) {
+	v, ok := b.Lookup(key)
+	if !ok {
+		return 0, b.missing(key)
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		return 0, fmt.Errorf("entry %q of binding %q is not an integer: %w", key, b.Name, err)
+	}
+	return i, nil
+}
+
+// Bool parses the value of the entry as a boolean.
+func (b *Binding) Bool(key string) (bool, error) {
+	v, ok := b.Lookup(key)
+	if !ok {
+		return false, b.missing(key)
+	}
+	p, err := strconv.ParseBool(strings.TrimSpace(v))
+	if err != nil {
+		return false, fmt.Errorf("entry %q of binding %q is not a boolean: %w", key, b.Name, err)
+	}
+	return p, nil
+}
+
+// Entries returns a copy of the entries of the binding.
+func (b *Binding) Entries() map[string][]byte {
+	entries := make(map[string][]byte, len(b.entries))
+	for k, v := range b.entries {
+		entries[k] = append([]byte(nil), v...)
+	}
+	return entries
+}
+
+func (b *Binding) missing(key string) error {
+	return fmt.Errorf("binding %q does not have a %q entry", b.Name, key)
+}
+
+// Root returns the directory the bindings are mounted under, read from
+// SERVICE_BINDING_ROOT.
+func Root() (string, error) {
+	root := os.Getenv(ServiceBindingRootEnv)
+	if root == "" {
+		return "", ErrRootNotSet
+	}
+	return root, nil
+}
+
+// Discover reads the bindings under SERVICE_BINDING_ROOT.
+func Discover() ([]*Binding, error) {
+	root, err := Root()
+	if err != nil {
+		return nil, err
+	}
+	return FromRoot(root)
+}
+
+// FromRoot reads each of the bindings under the directory, sorted by name.
+// Hidden files and directories, like those the kubelet maintains in
+// projected volumes, are ignored.
+func FromRoot(root string) ([]*Binding, error) {
+	files, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bindings in %q: %w", root, err)
+	}
+	bindings := []*Binding{}
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(root, f.Name())
+		if info, err := os.Stat(path); err != nil || !info.IsDir() {
+			continue
+		}
+		b, err := FromPath(path)
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, b)
+	}
+	return bindings, nil
+}
+
+// FromPath reads the binding in the directory, for bindings mounted at a
+// path of their own rather than under SERVICE_BINDING_ROOT.
+func FromPath(path string) (*Binding, error) {
+	files, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read binding %q: %w", path, err)
+	}
+	b := &Binding{
+		Name:    filepath.Base(path),
+		Path:    path,
+		entries: map[string][]byte{},
+	}
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		// entries of projected volumes are symlinks, which are followed
+		file := filepath.Join(path, f.Name())
+		if info, err := os.Stat(file); err != nil || info.IsDir() {
+			continue
+		}
+		v, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read entry %q of binding %q: %w", f.Name(), b.Name, err)
+		}
+		b.entries[f.Name()] = v
+	}
+	return b, nil
+}
+
+// Filter returns the bindings of the type and provider. Types and providers
+// are matched regardless of case, an empty type or provider matches any.
+func Filter(bindings []*Binding, bindingType, provider string) []*Binding {
+	matches := []*Binding{}
+	for _, b := range bindings {
+		if bindingType != "" && !strings.EqualFold(b.Type(), bindingType) {
+			continue
+		}
+		if provider != "" && !strings.EqualFold(b.Provider(), provider) {
+			continue
+		}
+		matches = append(matches, b)
+	}
+	return matches
+}
+
+// Find returns the single binding of the type and provider, failing when
+// none or several bindings match.
+func Find(bindings []*Binding, bindingType, provider string) (*Binding, error) {
+	matches := Filter(bindings, bindingType, provider)
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("no binding of type %q and provider %q found", bindingType, provider)
+	default:
+		names := make([]string, len(matches))
+		for i, b := range matches {
+			names[i] = b.Name
+		}
+		return nil, fmt.Errorf("several bindings of type %q and provider %q found: %s", bindingType, provider, strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// writeBinding lays out the binding as the kubelet does for a projected
+// Secret, each entry a symlink into a hidden data directory.
+func writeBinding(t *testing.T, root, name string, entries map[string]string) {
+	t.Helper()
+	dir := filepath.Join(root, name)
+	data := filepath.Join(dir, "..2020_01_01_00_00_00.000000000")
+	if err := os.MkdirAll(data, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Base(data), filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range entries {
+		if err := os.WriteFile(filepath.Join(data, k), []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join("..data", k), filepath.Join(dir, k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func names(bindings []*Binding) []string {
+	n := []string{}
+	for _, b := range bindings {
+		n = append(n, b.Name)
+	}
+	return n
+}
+
+func TestFromRoot(t *testing.T) {
+	root := t.TempDir()
+	writeBinding(t, root, "account-db", map[string]string{
+		"type":     "postgresql\n",
+		"provider": "bitnami",
+		"host":     "db.example.com",
+	})
+	writeBinding(t, root, "cache", map[string]string{
+		"type": "redis",
+		"host": "cache.example.com",
+	})
+	if err := os.WriteFile(filepath.Join(root, "README"), []byte("not a binding"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bindings, err := FromRoot(root)
+	if err != nil {
+		t.Fatalf("FromRoot() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"account-db", "cache"}, names(bindings)); diff != "" {
+		t.Errorf("FromRoot() names (-expected, +actual): %s", diff)
+	}
+	db := bindings[0]
+	if expected, actual := filepath.Join(root, "account-db"), db.Path; expected != actual {
+		t.Errorf("Path expected %q, got %q", expected, actual)
+	}
+	if expected, actual := "postgresql", db.Type(); expected != actual {
+		t.Errorf("Type() expected %q, got %q", expected, actual)
+	}
+	if expected, actual := "bitnami", db.Provider(); expected != actual {
+		t.Errorf("Provider() expected %q, got %q", expected, actual)
+	}
+	if diff := cmp.Diff([]string{"host", "provider", "type"}, db.Keys()); diff != "" {
+		t.Errorf("Keys() (-expected, +actual): %s", diff)
+	}
+	if host, ok := db.Lookup("host"); !ok || host != "db.example.com" {
+		t.Errorf("Lookup() expected %q, got %q", "db.example.com", host)
+	}
+	if _, ok := db.Lookup("password"); ok {
+		t.Errorf("Lookup() expected missing entry")
+	}
+}
+
+func TestFromRoot_Missing(t *testing.T) {
+	if _, err := FromRoot(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("FromRoot() expected error")
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	writeBinding(t, root, "cache", map[string]string{"type": "redis"})
+
+	t.Setenv(ServiceBindingRootEnv, "")
+	if _, err := Discover(); err != ErrRootNotSet {
+		t.Errorf("Discover() expected ErrRootNotSet, got %v", err)
+	}
+
+	t.Setenv(ServiceBindingRootEnv, root)
+	bindings, err := Discover()
+	if err != nil {
+		t.Fatalf("Discover() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"cache"}, names(bindings)); diff != "" {
+		t.Errorf("Discover() names (-expected, +actual): %s", diff)
+	}
+}
+
+func TestBinding_TypedAccessors(t *testing.T) {
+	b := NewBinding("my-binding", map[string][]byte{
+		"port":    []byte("5432\n"),
+		"ssl":     []byte("true"),
+		"invalid": []byte("nope"),
+	})
+
+	if port, err := b.Int("port"); err != nil || port != 5432 {
+		t.Errorf("Int() expected 5432, got %d, %v", port, err)
+	}
+	if _, err := b.Int("invalid"); err == nil {
+		t.Errorf("Int() expected error for an invalid entry")
+	}
+	if _, err := b.Int("missing"); err == nil {
+		t.Errorf("Int() expected error for a missing entry")
+	}
+	if ssl, err := b.Bool("ssl"); err != nil || !ssl {
+		t.Errorf("Bool() expected true, got %v, %v", ssl, err)
+	}
+	if _, err := b.Bool("invalid"); err == nil {
+		t.Errorf("Bool() expected error for an invalid entry")
+	}
+
+	entries := b.Entries()
+	entries["port"] = []byte("1")
+	if port, _ := b.Int("port"); port != 5432 {
+		t.Errorf("Entries() expected a copy of the entries")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	bindings := []*Binding{
+		NewBinding("account-db", map[string][]byte{"type": []byte("postgresql"), "provider": []byte("bitnami")}),
+		NewBinding("orders-db", map[string][]byte{"type": []byte("PostgreSQL"), "provider": []byte("crunchy")}),
+		NewBinding("cache", map[string][]byte{"type": []byte("redis")}),
+	}
+
+	tests := []struct {
+		name        string
+		bindingType string
+		provider    string
+		expected    []string
+	}{
+		{
+			name:     "any",
+			expected: []string{"account-db", "orders-db", "cache"},
+		},
+		{
+			name:        "type",
+			bindingType: "postgresql",
+			expected:    []string{"account-db", "orders-db"},
+		},
+		{
+			name:        "type and provider",
+			bindingType: "postgresql",
+			provider:    "Crunchy",
+			expected:    []string{"orders-db"},
+		},
+		{
+			name:        "no match",
+			bindingType: "mysql",
+			expected:    []string{},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := Filter(bindings, c.bindingType, c.provider)
+			if diff := cmp.Diff(c.expected, names(actual)); diff != "" {
+				t.Errorf("Filter() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	bindings := []*Binding{
+		NewBinding("account-db", map[string][]byte{"type": []byte("postgresql")}),
+		NewBinding("orders-db", map[string][]byte{"type": []byte("postgresql")}),
+		NewBinding("cache", map[string][]byte{"type": []byte("redis")}),
+	}
+
+	if b, err := Find(bindings, "redis", ""); err != nil || b.Name != "cache" {
+		t.Errorf("Find() expected binding %q, got %v, %v", "cache", b, err)
+	}
+	if _, err := Find(bindings, "postgresql", ""); err == nil {
+		t.Errorf("Find() expected error for several matches")
+	}
+	if _, err := Find(bindings, "mysql", ""); err == nil {
+		t.Errorf("Find() expected error for no match")
+	}
+}
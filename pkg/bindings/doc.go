@@ -0,0 +1,11 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package bindings reads the bindings projected into a workload, laid out as
+// $SERVICE_BINDING_ROOT/<name>/<entry> with the type and provider of each
+// binding held by its type and provider entries. It is meant to be imported
+// by the applications consuming bindings, so it only depends on the standard
+// library and the binding type schemas.
+package bindings
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindings
+
+import (
+	"bytes"
+	"context"
+	"time"
+)
+
+// DefaultWatchInterval is a reasonable interval to watch bindings at, the
+// kubelet takes up to a minute to update a mounted Secret once it changes.
+const DefaultWatchInterval = 10 * time.Second
+
+// Watch reads the bindings under the root every interval until the context
+// is done. onChange is called with the bindings initially read, and again
+// each time a binding is added or removed or its entries change, for example
+// when the kubelet updates the files of a rotated Secret. A read failing
+// while the kubelet swaps the files is retried at the next interval. An
+// error is only returned when the bindings can not be read initially.
+func Watch(ctx context.Context, root string, interval time.Duration, onChange func([]*Binding)) error {
+	current, err := FromRoot(root)
+	if err != nil {
+		return err
+	}
+	onChange(current)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			next, err := FromRoot(root)
+			if err != nil || equal(current, next) {
+				continue
+			}
+			current = next
+			onChange(current)
+		}
+	}
+}
+
+// equal returns true when both sets of bindings have the same names and
+// entries.
+func equal(a, b []*Binding) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || len(a[i].entries) != len(b[i].entries) {
+			return false
+		}
+		for k, v := range a[i].entries {
+			if w, ok := b[i].entries[k]; !ok || !bytes.Equal(v, w) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindings
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	root := t.TempDir()
+	writeBinding(t, root, "account-db", map[string]string{
+		"type":     "postgresql",
+		"password": "secret",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan []*Binding, 10)
+	done := make(chan error)
+	go func() {
+		done <- Watch(ctx, root, 10*time.Millisecond, func(bindings []*Binding) {
+			changes <- bindings
+		})
+	}()
+
+	next := func() []*Binding {
+		t.Helper()
+		select {
+		case bindings := <-changes:
+			return bindings
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the bindings to change")
+			return nil
+		}
+	}
+
+	if password := next()[0].String("password"); password != "secret" {
+		t.Errorf("Watch() expected initial password %q, got %q", "secret", password)
+	}
+
+	// rotate the password as the kubelet does, swapping the data directory
+	dir := filepath.Join(root, "account-db")
+	data := filepath.Join(dir, "..2020_01_02_00_00_00.000000000")
+	if err := os.MkdirAll(data, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]string{"type": "postgresql", "password": "rotated"} {
+		if err := os.WriteFile(filepath.Join(data, k), []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(filepath.Base(data), filepath.Join(dir, "..data_tmp")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+
+	if password := next()[0].String("password"); password != "rotated" {
+		t.Errorf("Watch() expected rotated password %q, got %q", "rotated", password)
+	}
+
+	// a hidden directory is ignored until moved into place
+	writeBinding(t, root, ".cache", map[string]string{"type": "redis"})
+	if err := os.Rename(filepath.Join(root, ".cache"), filepath.Join(root, "cache")); err != nil {
+		t.Fatal(err)
+	}
+	if bindings := next(); len(bindings) != 2 {
+		t.Errorf("Watch() expected 2 bindings, got %d", len(bindings))
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Watch() unexpected error: %v", err)
+	}
+	select {
+	case <-changes:
+		t.Errorf("Watch() unexpected change")
+	default:
+	}
+}
+
+func TestWatch_Missing(t *testing.T) {
+	err := Watch(context.Background(), filepath.Join(t.TempDir(), "missing"), time.Millisecond, func([]*Binding) {
+		t.Errorf("Watch() unexpected change")
+	})
+	if err == nil {
+		t.Errorf("Watch() expected error")
+	}
+}
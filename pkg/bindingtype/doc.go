@@ -0,0 +1,8 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package bindingtype validates the entries of a binding against the entries
+// its type implies, with a registry of validators keyed by binding type.
+package bindingtype
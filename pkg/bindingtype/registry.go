@@ -0,0 +1,92 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindingtype
+
+import (
+	"sort"
+	"strings"
+)
+
+// TypeKey is the entry of a binding holding its type.
+const TypeKey = "type"
+
+// Validator checks the entries of a binding of a type.
+type Validator interface {
+	// Missing describes each of the required entries missing from the
+	// binding, empty when the binding is valid.
+	Missing(data map[string][]byte) []string
+}
+
+// Registry holds the Validator for each binding type. Binding types are
+// matched regardless of case.
+type Registry struct {
+	validators map[string]Validator
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		validators: map[string]Validator{},
+	}
+}
+
+// Builtin creates a Registry holding the schemas of the well-known binding
+// types.
+func Builtin() *Registry {
+	r := NewRegistry()
+	for bindingType, s := range builtinSchemas {
+		schema, err := ParseSchema(s)
+		if err != nil {
+			panic(err)
+		}
+		r.Register(bindingType, schema)
+	}
+	return r
+}
+
+// builtinSchemas are the entries required for the well-known binding types,
+// in the format read by ParseSchema.
+var builtinSchemas = map[string]string{
+	"postgresql": "host, database, username, password",
+	"mysql":      "host, database, username, password",
+	"sqlserver":  "host, database, username, password",
+	"redis":      "host",
+	"rabbitmq":   "host|addresses, username, password",
+	"kafka":      "bootstrap-servers",
+	"mongodb":    "uri|host",
+}
+
+// Register sets the Validator for the binding type, replacing any existing
+// Validator for the type.
+func (r *Registry) Register(bindingType string, v Validator) {
+	r.validators[strings.ToLower(bindingType)] = v
+}
+
+// Lookup returns the Validator for the binding type.
+func (r *Registry) Lookup(bindingType string) (Validator, bool) {
+	v, ok := r.validators[strings.ToLower(bindingType)]
+	return v, ok
+}
+
+// Types returns the registered binding types, sorted.
+func (r *Registry) Types() []string {
+	types := make([]string, 0, len(r.validators))
+	for t := range r.validators {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Copy creates a Registry holding the same Validators, which may be extended
+// without affecting this Registry.
+func (r *Registry) Copy() *Registry {
+	c := NewRegistry()
+	for t, v := range r.validators {
+		c.validators[t] = v
+	}
+	return c
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindingtype
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuiltin(t *testing.T) {
+	registry := Builtin()
+
+	expected := []string{"kafka", "mongodb", "mysql", "postgresql", "rabbitmq", "redis", "sqlserver"}
+	if diff := cmp.Diff(expected, registry.Types()); diff != "" {
+		t.Errorf("Types() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("Oracle", Schema{{"host"}})
+
+	if _, ok := registry.Lookup("oracle"); !ok {
+		t.Errorf("Lookup() expected validator for %q", "oracle")
+	}
+	if _, ok := registry.Lookup("ORACLE"); !ok {
+		t.Errorf("Lookup() expected validator for %q", "ORACLE")
+	}
+	if _, ok := registry.Lookup("db2"); ok {
+		t.Errorf("Lookup() unexpected validator for %q", "db2")
+	}
+
+	c := registry.Copy()
+	c.Register("db2", Schema{{"host"}})
+	if _, ok := registry.Lookup("db2"); ok {
+		t.Errorf("Lookup() unexpected validator for %q registered on a copy", "db2")
+	}
+	if _, ok := c.Lookup("oracle"); !ok {
+		t.Errorf("Lookup() expected copied validator for %q", "oracle")
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindingtype
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Schema is a Validator requiring a set of entries in a binding. Each
+// requirement lists alternative keys, any one of which satisfies it.
+type Schema [][]string
+
+// ParseSchema reads a Schema from a comma separated list of required keys.
+// Alternative keys for a requirement are separated by `|`, e.g.
+// `host|addresses, username, password`.
+func ParseSchema(s string) (Schema, error) {
+	schema := Schema{}
+	for _, requirement := range strings.Split(s, ",") {
+		requirement = strings.TrimSpace(requirement)
+		if requirement == "" {
+			continue
+		}
+		alternatives := []string{}
+		for _, key := range strings.Split(requirement, "|") {
+			key = strings.TrimSpace(key)
+			if errs := validation.IsConfigMapKey(key); len(errs) != 0 {
+				return nil, fmt.Errorf("invalid key %q: %s", key, strings.Join(errs, ", "))
+			}
+			alternatives = append(alternatives, key)
+		}
+		schema = append(schema, alternatives)
+	}
+	if len(schema) == 0 {
+		return nil, fmt.Errorf("at least one key is required")
+	}
+	return schema, nil
+}
+
+// Missing implements Validator.
+func (s Schema) Missing(data map[string][]byte) []string {
+	missing := []string{}
+	for _, alternatives := range s {
+		found := false
+		for _, key := range alternatives {
+			if _, ok := data[key]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, strings.Join(alternatives, " or "))
+		}
+	}
+	return missing
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bindingtype
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSchema(t *testing.T) {
+	tests := []struct {
+		name        string
+		schema      string
+		expected    Schema
+		expectedErr bool
+	}{
+		{
+			name:     "keys",
+			schema:   "host, username,password",
+			expected: Schema{{"host"}, {"username"}, {"password"}},
+		},
+		{
+			name:     "alternatives",
+			schema:   "host | addresses, username",
+			expected: Schema{{"host", "addresses"}, {"username"}},
+		},
+		{
+			name:     "trailing comma",
+			schema:   "host,",
+			expected: Schema{{"host"}},
+		},
+		{
+			name:        "empty",
+			schema:      " ",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid key",
+			schema:      "host/name",
+			expectedErr: true,
+		},
+		{
+			name:        "empty alternative",
+			schema:      "host|",
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := ParseSchema(c.schema)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("ParseSchema() expected err %v, got %v", c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ParseSchema() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchema_Missing(t *testing.T) {
+	schema := Schema{{"host", "addresses"}, {"username"}, {"password"}}
+
+	tests := []struct {
+		name     string
+		data     map[string][]byte
+		expected []string
+	}{
+		{
+			name: "valid",
+			data: map[string][]byte{
+				"host":     []byte("localhost"),
+				"username": []byte("user"),
+				"password": []byte(""),
+			},
+			expected: []string{},
+		},
+		{
+			name: "alternative",
+			data: map[string][]byte{
+				"addresses": []byte("localhost:5672"),
+				"username":  []byte("user"),
+				"password":  []byte("secret"),
+			},
+			expected: []string{},
+		},
+		{
+			name: "missing",
+			data: map[string][]byte{
+				"username": []byte("user"),
+			},
+			expected: []string{"host or addresses", "password"},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expected, schema.Missing(c.data)); diff != "" {
+				t.Errorf("Missing() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	bindingsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/labs/v1alpha1"
 	internalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/labsinternal/v1alpha1"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/servicebinding/v1alpha3"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/servicebinding/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -25,6 +26,7 @@ type Interface interface {
 	BindingsV1alpha1() bindingsv1alpha1.BindingsV1alpha1Interface
 	InternalV1alpha1() internalv1alpha1.InternalV1alpha1Interface
 	ServicebindingV1alpha3() servicebindingv1alpha3.ServicebindingV1alpha3Interface
+	ServicebindingV1beta1() servicebindingv1beta1.ServicebindingV1beta1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -35,6 +37,7 @@ type Clientset struct {
 	bindingsV1alpha1       *bindingsv1alpha1.BindingsV1alpha1Client
 	internalV1alpha1       *internalv1alpha1.InternalV1alpha1Client
 	servicebindingV1alpha3 *servicebindingv1alpha3.ServicebindingV1alpha3Client
+	servicebindingV1beta1  *servicebindingv1beta1.ServicebindingV1beta1Client
 }
 
 // DuckV1alpha3 retrieves the DuckV1alpha3Client
@@ -57,6 +60,11 @@ func (c *Clientset) ServicebindingV1alpha3() servicebindingv1alpha3.Servicebindi
 	return c.servicebindingV1alpha3
 }
 
+// ServicebindingV1beta1 retrieves the ServicebindingV1beta1Client
+func (c *Clientset) ServicebindingV1beta1() servicebindingv1beta1.ServicebindingV1beta1Interface {
+	return c.servicebindingV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -94,6 +102,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.servicebindingV1beta1, err = servicebindingv1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -110,6 +122,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	cs.bindingsV1alpha1 = bindingsv1alpha1.NewForConfigOrDie(c)
 	cs.internalV1alpha1 = internalv1alpha1.NewForConfigOrDie(c)
 	cs.servicebindingV1alpha3 = servicebindingv1alpha3.NewForConfigOrDie(c)
+	cs.servicebindingV1beta1 = servicebindingv1beta1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -122,6 +135,7 @@ func New(c rest.Interface) *Clientset {
 	cs.bindingsV1alpha1 = bindingsv1alpha1.New(c)
 	cs.internalV1alpha1 = internalv1alpha1.New(c)
 	cs.servicebindingV1alpha3 = servicebindingv1alpha3.New(c)
+	cs.servicebindingV1beta1 = servicebindingv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
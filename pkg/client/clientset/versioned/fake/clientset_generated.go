@@ -17,6 +17,8 @@ import (
 	fakeinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/labsinternal/v1alpha1/fake"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/servicebinding/v1alpha3"
 	fakeservicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/servicebinding/v1alpha3/fake"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/servicebinding/v1beta1"
+	fakeservicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/typed/servicebinding/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -90,3 +92,8 @@ func (c *Clientset) InternalV1alpha1() internalv1alpha1.InternalV1alpha1Interfac
 func (c *Clientset) ServicebindingV1alpha3() servicebindingv1alpha3.ServicebindingV1alpha3Interface {
 	return &fakeservicebindingv1alpha3.FakeServicebindingV1alpha3{Fake: &c.Fake}
 }
+
+// ServicebindingV1beta1 retrieves the ServicebindingV1beta1Client
+func (c *Clientset) ServicebindingV1beta1() servicebindingv1beta1.ServicebindingV1beta1Interface {
+	return &fakeservicebindingv1beta1.FakeServicebindingV1beta1{Fake: &c.Fake}
+}
@@ -12,6 +12,7 @@ import (
 	bindingsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	internalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -27,6 +28,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	bindingsv1alpha1.AddToScheme,
 	internalv1alpha1.AddToScheme,
 	servicebindingv1alpha3.AddToScheme,
+	servicebindingv1beta1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
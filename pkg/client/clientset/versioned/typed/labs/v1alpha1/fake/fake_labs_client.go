@@ -21,6 +21,14 @@ func (c *FakeBindingsV1alpha1) ProvisionedServices(namespace string) v1alpha1.Pr
 	return &FakeProvisionedServices{c, namespace}
 }
 
+func (c *FakeBindingsV1alpha1) ServiceBindingClasses(namespace string) v1alpha1.ServiceBindingClassInterface {
+	return &FakeServiceBindingClasses{c, namespace}
+}
+
+func (c *FakeBindingsV1alpha1) ServiceBindingGrants(namespace string) v1alpha1.ServiceBindingGrantInterface {
+	return &FakeServiceBindingGrants{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeBindingsV1alpha1) RESTClient() rest.Interface {
@@ -8,3 +8,7 @@ SPDX-License-Identifier: Apache-2.0
 package v1alpha1
 
 type ProvisionedServiceExpansion interface{}
+
+type ServiceBindingClassExpansion interface{}
+
+type ServiceBindingGrantExpansion interface{}
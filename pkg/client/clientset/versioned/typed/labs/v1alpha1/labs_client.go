@@ -16,6 +16,8 @@ import (
 type BindingsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ProvisionedServicesGetter
+	ServiceBindingClassesGetter
+	ServiceBindingGrantsGetter
 }
 
 // BindingsV1alpha1Client is used to interact with features provided by the bindings.labs.vmware.com group.
@@ -27,6 +29,14 @@ func (c *BindingsV1alpha1Client) ProvisionedServices(namespace string) Provision
 	return newProvisionedServices(c, namespace)
 }
 
+func (c *BindingsV1alpha1Client) ServiceBindingClasses(namespace string) ServiceBindingClassInterface {
+	return newServiceBindingClasses(c, namespace)
+}
+
+func (c *BindingsV1alpha1Client) ServiceBindingGrants(namespace string) ServiceBindingGrantInterface {
+	return newServiceBindingGrants(c, namespace)
+}
+
 // NewForConfig creates a new BindingsV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*BindingsV1alpha1Client, error) {
 	config := *c
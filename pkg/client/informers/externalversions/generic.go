@@ -13,6 +13,7 @@ import (
 	v1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	v1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	v1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -46,6 +47,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=bindings.labs.vmware.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("provisionedservices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Bindings().V1alpha1().ProvisionedServices().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("servicebindingclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Bindings().V1alpha1().ServiceBindingClasses().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("servicebindinggrants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Bindings().V1alpha1().ServiceBindingGrants().Informer()}, nil
 
 		// Group=internal.bindings.labs.vmware.com, Version=v1alpha1
 	case labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"):
@@ -55,6 +60,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha3.SchemeGroupVersion.WithResource("servicebindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicebinding().V1alpha3().ServiceBindings().Informer()}, nil
 
+		// Group=servicebinding.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("clusterworkloadresourcemappings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicebinding().V1beta1().ClusterWorkloadResourceMappings().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("servicebindings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Servicebinding().V1beta1().ServiceBindings().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -15,6 +15,10 @@ import (
 type Interface interface {
 	// ProvisionedServices returns a ProvisionedServiceInformer.
 	ProvisionedServices() ProvisionedServiceInformer
+	// ServiceBindingClasses returns a ServiceBindingClassInformer.
+	ServiceBindingClasses() ServiceBindingClassInformer
+	// ServiceBindingGrants returns a ServiceBindingGrantInformer.
+	ServiceBindingGrants() ServiceBindingGrantInformer
 }
 
 type version struct {
@@ -32,3 +36,13 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) ProvisionedServices() ProvisionedServiceInformer {
 	return &provisionedServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ServiceBindingClasses returns a ServiceBindingClassInformer.
+func (v *version) ServiceBindingClasses() ServiceBindingClassInformer {
+	return &serviceBindingClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ServiceBindingGrants returns a ServiceBindingGrantInformer.
+func (v *version) ServiceBindingGrants() ServiceBindingGrantInformer {
+	return &serviceBindingGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -14,3 +14,19 @@ type ProvisionedServiceListerExpansion interface{}
 // ProvisionedServiceNamespaceListerExpansion allows custom methods to be added to
 // ProvisionedServiceNamespaceLister.
 type ProvisionedServiceNamespaceListerExpansion interface{}
+
+// ServiceBindingClassListerExpansion allows custom methods to be added to
+// ServiceBindingClassLister.
+type ServiceBindingClassListerExpansion interface{}
+
+// ServiceBindingClassNamespaceListerExpansion allows custom methods to be added to
+// ServiceBindingClassNamespaceLister.
+type ServiceBindingClassNamespaceListerExpansion interface{}
+
+// ServiceBindingGrantListerExpansion allows custom methods to be added to
+// ServiceBindingGrantLister.
+type ServiceBindingGrantListerExpansion interface{}
+
+// ServiceBindingGrantNamespaceListerExpansion allows custom methods to be added to
+// ServiceBindingGrantNamespaceLister.
+type ServiceBindingGrantNamespaceListerExpansion interface{}
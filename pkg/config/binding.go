@@ -0,0 +1,159 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+)
+
+const (
+	// BindingConfigName is the name of the ConfigMap holding the binding
+	// configuration.
+	BindingConfigName = "config-binding"
+
+	mountRootKey     = "mount-root"
+	selectionModeKey = "selection-mode"
+
+	// MountRootAnnotationKey may be set on a Namespace to override the
+	// mount root for the workloads in the namespace.
+	MountRootAnnotationKey = "bindings.labs.vmware.com/mount-root"
+
+	// SelectionModeExclusion binds every workload that is not opted out.
+	SelectionModeExclusion = "exclusion"
+	// SelectionModeInclusion only binds workloads that are opted in.
+	SelectionModeInclusion = "inclusion"
+
+	// BindingExcludeLabel opts a workload, or every workload in a namespace,
+	// out of being bound, whatever the selection mode.
+	BindingExcludeLabel = "bindings.labs.vmware.com/exclude"
+	// BindingIncludeLabel opts a workload, or every workload in a namespace,
+	// in to being bound, whatever the selection mode.
+	BindingIncludeLabel = "bindings.labs.vmware.com/include"
+
+	// LegacyBindingExcludeLabel is honored like BindingExcludeLabel until
+	// resources are migrated to the new label.
+	LegacyBindingExcludeLabel = "knative.dev.bindings.labs.vmware.com/exclude"
+	// LegacyBindingIncludeLabel is honored like BindingIncludeLabel until
+	// resources are migrated to the new label.
+	LegacyBindingIncludeLabel = "knative.dev.bindings.labs.vmware.com/include"
+)
+
+// Binding is the configuration applied when projecting bindings into
+// workloads.
+type Binding struct {
+	// MountRoot is the directory bindings are mounted under, for containers
+	// that do not define SERVICE_BINDING_ROOT.
+	MountRoot string
+	// SelectionMode is either SelectionModeExclusion or
+	// SelectionModeInclusion, deciding whether workloads that are not
+	// labeled are bound.
+	SelectionMode string
+}
+
+// NewBindingFromConfigMap creates a Binding from the supplied ConfigMap.
+func NewBindingFromConfigMap(cm *corev1.ConfigMap) (*Binding, error) {
+	c := &Binding{
+		MountRoot:     labsinternalv1alpha1.DefaultMountRoot,
+		SelectionMode: SelectionModeExclusion,
+	}
+	if root, ok := cm.Data[mountRootKey]; ok {
+		if !isValidMountRoot(root) {
+			return nil, fmt.Errorf("%s must be an absolute path, got %q", mountRootKey, root)
+		}
+		c.MountRoot = path.Clean(root)
+	}
+	if mode, ok := cm.Data[selectionModeKey]; ok {
+		if mode != SelectionModeExclusion && mode != SelectionModeInclusion {
+			return nil, fmt.Errorf("%s must be %q or %q, got %q", selectionModeKey, SelectionModeExclusion, SelectionModeInclusion, mode)
+		}
+		c.SelectionMode = mode
+	}
+	return c, nil
+}
+
+// MountRootForNamespace returns the mount root for workloads in the
+// namespace. A valid mount root annotation on the namespace takes precedence
+// over the configured mount root.
+func (c *Binding) MountRootForNamespace(ns *corev1.Namespace) string {
+	if ns != nil {
+		if root, ok := ns.Annotations[MountRootAnnotationKey]; ok && isValidMountRoot(root) {
+			return path.Clean(root)
+		}
+	}
+	return c.MountRoot
+}
+
+// Selects returns true when a workload with the labels, in the namespace, is
+// to be bound. A workload or namespace labeled to be excluded is never bound,
+// a namespace labeled to be included binds every workload that isn't
+// excluded. Otherwise, the workload is bound in the exclusion mode, or when
+// it's labeled to be included.
+//
+// The legacy labels are honored the same way as the labels that replace them.
+func (c *Binding) Selects(ns *corev1.Namespace, workloadLabels map[string]string) bool {
+	if isLabeled(workloadLabels, BindingExcludeLabel, LegacyBindingExcludeLabel) {
+		return false
+	}
+	if ns != nil {
+		switch {
+		case isLabeled(ns.Labels, BindingExcludeLabel, LegacyBindingExcludeLabel):
+			return false
+		case isLabeled(ns.Labels, BindingIncludeLabel, LegacyBindingIncludeLabel):
+			return true
+		}
+	}
+	if c.SelectionMode == SelectionModeInclusion {
+		return isLabeled(workloadLabels, BindingIncludeLabel, LegacyBindingIncludeLabel)
+	}
+	return true
+}
+
+// isLabeled returns true when the label, or its legacy equivalent, is "true".
+func isLabeled(labels map[string]string, label, legacyLabel string) bool {
+	return labels[label] == "true" || labels[legacyLabel] == "true"
+}
+
+// legacyLabels maps each legacy label to the label replacing it.
+var legacyLabels = map[string]string{
+	LegacyBindingExcludeLabel: BindingExcludeLabel,
+	LegacyBindingIncludeLabel: BindingIncludeLabel,
+}
+
+// LegacyLabels returns the legacy labels set, sorted.
+func LegacyLabels(labels map[string]string) []string {
+	found := []string{}
+	for legacy := range legacyLabels {
+		if _, ok := labels[legacy]; ok {
+			found = append(found, legacy)
+		}
+	}
+	sort.Strings(found)
+	return found
+}
+
+// MigrateLegacyLabels replaces the legacy labels with the labels replacing
+// them, in place. A label that is already set wins over its legacy
+// equivalent. The legacy labels that were replaced are returned.
+func MigrateLegacyLabels(labels map[string]string) []string {
+	migrated := LegacyLabels(labels)
+	for _, legacy := range migrated {
+		if _, ok := labels[legacyLabels[legacy]]; !ok {
+			labels[legacyLabels[legacy]] = labels[legacy]
+		}
+		delete(labels, legacy)
+	}
+	return migrated
+}
+
+func isValidMountRoot(root string) bool {
+	return path.IsAbs(root)
+}
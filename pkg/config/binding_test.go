@@ -0,0 +1,338 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewBindingFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]string
+		expected    *Binding
+		expectedErr bool
+	}{
+		{
+			name: "defaults",
+			expected: &Binding{
+				MountRoot:     "/bindings",
+				SelectionMode: "exclusion",
+			},
+		},
+		{
+			name: "mount root",
+			data: map[string]string{
+				"mount-root": "/platform/bindings/",
+			},
+			expected: &Binding{
+				MountRoot:     "/platform/bindings",
+				SelectionMode: "exclusion",
+			},
+		},
+		{
+			name: "relative mount root",
+			data: map[string]string{
+				"mount-root": "bindings",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty mount root",
+			data: map[string]string{
+				"mount-root": "",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "inclusion mode",
+			data: map[string]string{
+				"selection-mode": "inclusion",
+			},
+			expected: &Binding{
+				MountRoot:     "/bindings",
+				SelectionMode: "inclusion",
+			},
+		},
+		{
+			name: "invalid selection mode",
+			data: map[string]string{
+				"selection-mode": "Inclusion",
+			},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := NewBindingFromConfigMap(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: BindingConfigName},
+				Data:       c.data,
+			})
+			if (err != nil) != c.expectedErr {
+				t.Errorf("NewBindingFromConfigMap() expected err %v, got %v", c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("NewBindingFromConfigMap() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBinding_MountRootForNamespace(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    string
+	}{
+		{
+			name:     "configured",
+			expected: "/platform/bindings",
+		},
+		{
+			name: "namespace override",
+			annotations: map[string]string{
+				"bindings.labs.vmware.com/mount-root": "/team/bindings/",
+			},
+			expected: "/team/bindings",
+		},
+		{
+			name: "invalid namespace override",
+			annotations: map[string]string{
+				"bindings.labs.vmware.com/mount-root": "team/bindings",
+			},
+			expected: "/platform/bindings",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			binding := &Binding{MountRoot: "/platform/bindings"}
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "my-namespace",
+					Annotations: c.annotations,
+				},
+			}
+			if diff := cmp.Diff(c.expected, binding.MountRootForNamespace(ns)); diff != "" {
+				t.Errorf("MountRootForNamespace() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+
+	t.Run("missing namespace", func(t *testing.T) {
+		binding := &Binding{MountRoot: "/platform/bindings"}
+		if actual := binding.MountRootForNamespace(nil); actual != "/platform/bindings" {
+			t.Errorf("MountRootForNamespace() expected %q, got %q", "/platform/bindings", actual)
+		}
+	})
+}
+
+func TestBinding_Selects(t *testing.T) {
+	tests := []struct {
+		name            string
+		mode            string
+		namespaceLabels map[string]string
+		workloadLabels  map[string]string
+		expected        bool
+	}{
+		{
+			name:     "exclusion",
+			mode:     "exclusion",
+			expected: true,
+		},
+		{
+			name: "exclusion, excluded workload",
+			mode: "exclusion",
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/exclude": "true",
+			},
+			expected: false,
+		},
+		{
+			name: "exclusion, excluded namespace",
+			mode: "exclusion",
+			namespaceLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/exclude": "true",
+			},
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/include": "true",
+			},
+			expected: false,
+		},
+		{
+			name:     "inclusion",
+			mode:     "inclusion",
+			expected: false,
+		},
+		{
+			name: "inclusion, included workload",
+			mode: "inclusion",
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/include": "true",
+			},
+			expected: true,
+		},
+		{
+			name: "inclusion, included namespace",
+			mode: "inclusion",
+			namespaceLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/include": "true",
+			},
+			expected: true,
+		},
+		{
+			name: "inclusion, excluded workload in included namespace",
+			mode: "inclusion",
+			namespaceLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/include": "true",
+			},
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/exclude": "true",
+			},
+			expected: false,
+		},
+		{
+			name: "inclusion, included workload in excluded namespace",
+			mode: "inclusion",
+			namespaceLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/exclude": "true",
+			},
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/include": "true",
+			},
+			expected: false,
+		},
+		{
+			name: "exclusion, excluded workload by new label",
+			mode: "exclusion",
+			workloadLabels: map[string]string{
+				"bindings.labs.vmware.com/exclude": "true",
+			},
+			expected: false,
+		},
+		{
+			name: "inclusion, included namespace by new label",
+			mode: "inclusion",
+			namespaceLabels: map[string]string{
+				"bindings.labs.vmware.com/include": "true",
+			},
+			expected: true,
+		},
+		{
+			name: "inclusion, legacy excluded workload in included namespace",
+			mode: "inclusion",
+			namespaceLabels: map[string]string{
+				"bindings.labs.vmware.com/include": "true",
+			},
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/exclude": "true",
+			},
+			expected: false,
+		},
+		{
+			name: "inclusion, workload not included",
+			mode: "inclusion",
+			workloadLabels: map[string]string{
+				"knative.dev.bindings.labs.vmware.com/include": "false",
+			},
+			expected: false,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			binding := &Binding{SelectionMode: c.mode}
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "my-namespace",
+					Labels: c.namespaceLabels,
+				},
+			}
+			if actual := binding.Selects(ns, c.workloadLabels); actual != c.expected {
+				t.Errorf("Selects() expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+
+	t.Run("missing namespace", func(t *testing.T) {
+		binding := &Binding{SelectionMode: "inclusion"}
+		if binding.Selects(nil, nil) {
+			t.Errorf("Selects() expected false")
+		}
+	})
+}
+
+func TestMigrateLegacyLabels(t *testing.T) {
+	tests := []struct {
+		name             string
+		labels           map[string]string
+		expectedLabels   map[string]string
+		expectedMigrated []string
+	}{
+		{
+			name:             "no labels",
+			expectedMigrated: []string{},
+		},
+		{
+			name: "no legacy labels",
+			labels: map[string]string{
+				"app":                              "accounts",
+				"bindings.labs.vmware.com/exclude": "true",
+			},
+			expectedLabels: map[string]string{
+				"app":                              "accounts",
+				"bindings.labs.vmware.com/exclude": "true",
+			},
+			expectedMigrated: []string{},
+		},
+		{
+			name: "legacy labels",
+			labels: map[string]string{
+				"app": "accounts",
+				"knative.dev.bindings.labs.vmware.com/exclude": "true",
+				"knative.dev.bindings.labs.vmware.com/include": "false",
+			},
+			expectedLabels: map[string]string{
+				"app":                              "accounts",
+				"bindings.labs.vmware.com/exclude": "true",
+				"bindings.labs.vmware.com/include": "false",
+			},
+			expectedMigrated: []string{
+				"knative.dev.bindings.labs.vmware.com/exclude",
+				"knative.dev.bindings.labs.vmware.com/include",
+			},
+		},
+		{
+			name: "new label wins",
+			labels: map[string]string{
+				"bindings.labs.vmware.com/include":             "true",
+				"knative.dev.bindings.labs.vmware.com/include": "false",
+			},
+			expectedLabels: map[string]string{
+				"bindings.labs.vmware.com/include": "true",
+			},
+			expectedMigrated: []string{
+				"knative.dev.bindings.labs.vmware.com/include",
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if diff := cmp.Diff(c.expectedMigrated, LegacyLabels(c.labels)); diff != "" {
+				t.Errorf("LegacyLabels() (-expected, +actual): %s", diff)
+			}
+			migrated := MigrateLegacyLabels(c.labels)
+			if diff := cmp.Diff(c.expectedMigrated, migrated); diff != "" {
+				t.Errorf("MigrateLegacyLabels() migrated (-expected, +actual): %s", diff)
+			}
+			if diff := cmp.Diff(c.expectedLabels, c.labels); diff != "" {
+				t.Errorf("MigrateLegacyLabels() labels (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
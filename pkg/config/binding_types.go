@@ -0,0 +1,40 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/bindingtype"
+)
+
+const (
+	// BindingTypesConfigName is the name of the ConfigMap holding the
+	// schemas of additional binding types.
+	BindingTypesConfigName = "config-binding-types"
+)
+
+// NewBindingTypesFromConfigMap creates a registry of binding type validators
+// from the supplied ConfigMap. Each entry is the schema of the binding type
+// named by its key, in addition to or replacing the well-known binding types.
+func NewBindingTypesFromConfigMap(cm *corev1.ConfigMap) (*bindingtype.Registry, error) {
+	registry := bindingtype.Builtin()
+	for bindingType, s := range cm.Data {
+		if strings.HasPrefix(bindingType, "_") {
+			// reserved for examples
+			continue
+		}
+		schema, err := bindingtype.ParseSchema(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema for binding type %q: %w", bindingType, err)
+		}
+		registry.Register(bindingType, schema)
+	}
+	return registry, nil
+}
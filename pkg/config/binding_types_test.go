@@ -0,0 +1,74 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/bindingtype"
+)
+
+func TestNewBindingTypesFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]string
+		lookup      string
+		expected    bindingtype.Validator
+		expectedErr bool
+	}{
+		{
+			name:     "builtin",
+			lookup:   "postgresql",
+			expected: bindingtype.Schema{{"host"}, {"database"}, {"username"}, {"password"}},
+		},
+		{
+			name: "additional type",
+			data: map[string]string{
+				"_example": "ignored",
+				"oracle":   "host, sid|service-name",
+			},
+			lookup:   "oracle",
+			expected: bindingtype.Schema{{"host"}, {"sid", "service-name"}},
+		},
+		{
+			name: "replaced type",
+			data: map[string]string{
+				"redis": "host, port",
+			},
+			lookup:   "redis",
+			expected: bindingtype.Schema{{"host"}, {"port"}},
+		},
+		{
+			name: "invalid schema",
+			data: map[string]string{
+				"oracle": "",
+			},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := NewBindingTypesFromConfigMap(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: BindingTypesConfigName},
+				Data:       c.data,
+			})
+			if (err != nil) != c.expectedErr {
+				t.Fatalf("NewBindingTypesFromConfigMap() expected err %v, got %v", c.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+			v, _ := actual.Lookup(c.lookup)
+			if diff := cmp.Diff(c.expected, v); diff != "" {
+				t.Errorf("NewBindingTypesFromConfigMap() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package config holds the operator configuration of the service binding
+// manager, read from ConfigMaps in the system namespace.
+package config
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/configmap"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/bindingtype"
+)
+
+type cfgKey struct{}
+
+// Config holds the collection of configurations that we attach to contexts.
+type Config struct {
+	Binding      *Binding
+	BindingTypes *bindingtype.Registry
+}
+
+// FromContext extracts a Config from the provided context.
+func FromContext(ctx context.Context) *Config {
+	x, ok := ctx.Value(cfgKey{}).(*Config)
+	if ok {
+		return x
+	}
+	return nil
+}
+
+// FromContextOrDefaults is like FromContext, but when no Config is attached
+// it returns a Config populated with the defaults for each of the Config
+// fields.
+func FromContextOrDefaults(ctx context.Context) *Config {
+	if cfg := FromContext(ctx); cfg != nil {
+		return cfg
+	}
+	return defaultConfig()
+}
+
+// ToContext attaches the provided Config to the provided context, returning
+// the new context with the Config attached.
+func ToContext(ctx context.Context, c *Config) context.Context {
+	return context.WithValue(ctx, cfgKey{}, c)
+}
+
+// Store is a typed wrapper around configmap.UntypedStore to handle our
+// ConfigMaps.
+type Store struct {
+	*configmap.UntypedStore
+}
+
+// NewStore creates a new store of Configs and optionally calls functions when
+// ConfigMaps are updated.
+func NewStore(logger configmap.Logger, onAfterStore ...func(name string, value interface{})) *Store {
+	return &Store{
+		UntypedStore: configmap.NewUntypedStore(
+			"binding",
+			logger,
+			configmap.Constructors{
+				BindingConfigName:      NewBindingFromConfigMap,
+				BindingTypesConfigName: NewBindingTypesFromConfigMap,
+			},
+			onAfterStore...,
+		),
+	}
+}
+
+// ToContext attaches the current Config state to the provided context.
+func (s *Store) ToContext(ctx context.Context) context.Context {
+	return ToContext(ctx, s.Load())
+}
+
+// Load creates a Config from the current config state of the Store. Defaults
+// are used until the ConfigMaps are observed.
+func (s *Store) Load() *Config {
+	cfg := defaultConfig()
+	if binding, ok := s.UntypedLoad(BindingConfigName).(*Binding); ok {
+		cfg.Binding = binding
+	}
+	if bindingTypes, ok := s.UntypedLoad(BindingTypesConfigName).(*bindingtype.Registry); ok {
+		cfg.BindingTypes = bindingTypes
+	}
+	return cfg
+}
+
+func defaultConfig() *Config {
+	binding, _ := NewBindingFromConfigMap(&corev1.ConfigMap{})
+	bindingTypes, _ := NewBindingTypesFromConfigMap(&corev1.ConfigMap{})
+	return &Config{
+		Binding:      binding,
+		BindingTypes: bindingTypes,
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestStore(t *testing.T) {
+	store := NewStore(logtesting.TestLogger(t))
+
+	if diff := cmp.Diff(&Binding{MountRoot: "/bindings", SelectionMode: "exclusion"}, store.Load().Binding); diff != "" {
+		t.Errorf("Load() before OnConfigChanged (-expected, +actual): %s", diff)
+	}
+
+	store.OnConfigChanged(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: BindingConfigName},
+		Data: map[string]string{
+			"mount-root": "/platform/bindings",
+		},
+	})
+
+	ctx := store.ToContext(context.Background())
+	if diff := cmp.Diff(&Binding{MountRoot: "/platform/bindings", SelectionMode: "exclusion"}, FromContext(ctx).Binding); diff != "" {
+		t.Errorf("FromContext() (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff(&Binding{MountRoot: "/bindings", SelectionMode: "exclusion"}, FromContextOrDefaults(context.Background()).Binding); diff != "" {
+		t.Errorf("FromContextOrDefaults() (-expected, +actual): %s", diff)
+	}
+	if _, ok := FromContextOrDefaults(context.Background()).BindingTypes.Lookup("postgresql"); !ok {
+		t.Errorf("FromContextOrDefaults() expected builtin binding type %q", "postgresql")
+	}
+
+	store.OnConfigChanged(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: BindingTypesConfigName},
+		Data: map[string]string{
+			"oracle": "host",
+		},
+	})
+
+	bindingTypes := store.Load().BindingTypes
+	if _, ok := bindingTypes.Lookup("oracle"); !ok {
+		t.Errorf("Load() expected binding type %q", "oracle")
+	}
+	if _, ok := bindingTypes.Lookup("postgresql"); !ok {
+		t.Errorf("Load() expected builtin binding type %q", "postgresql")
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	pkgmetrics "knative.dev/pkg/metrics"
+)
+
+const (
+	serviceBindingReadyCountName  = "servicebinding_ready_count"
+	projectionMutationLatencyName = "projection_mutation_latencies"
+	bindingAdmissionFailuresName  = "binding_admission_failure_count"
+	projectionWorkloadCountName   = "projection_workload_count"
+	resolverInformerCacheName     = "resolver_informer_cache_count"
+	legacyLabelCountName          = "binding_legacy_label_count"
+)
+
+var (
+	serviceBindingReadyCountM = stats.Int64(
+		serviceBindingReadyCountName,
+		"The number of ServiceBindings by status and reason of the Ready condition",
+		stats.UnitDimensionless)
+	projectionMutationLatencyM = stats.Float64(
+		projectionMutationLatencyName,
+		"The time in milliseconds to apply (Do) or remove (Undo) a projection in the binding webhook",
+		stats.UnitMilliseconds)
+	bindingAdmissionFailuresM = stats.Int64(
+		bindingAdmissionFailuresName,
+		"The number of admission requests the binding webhook failed to mutate",
+		stats.UnitDimensionless)
+	projectionWorkloadCountM = stats.Int64(
+		projectionWorkloadCountName,
+		"The number of workloads bound by ServiceBindingProjections",
+		stats.UnitDimensionless)
+	resolverInformerCacheM = stats.Int64(
+		resolverInformerCacheName,
+		"The number of resources cached by the service resolver informer for a resource type",
+		stats.UnitDimensionless)
+	legacyLabelCountM = stats.Int64(
+		legacyLabelCountName,
+		"The number of admission requests for workloads, or workloads in namespaces, carrying a legacy selection label",
+		stats.UnitDimensionless)
+
+	// Create the tag keys that will be used to add tags to our measurements.
+	// Tag keys must conform to the restrictions described in
+	// go.opencensus.io/tag/validate.go.
+	statusKey           = tag.MustNewKey("status")
+	reasonKey           = tag.MustNewKey("reason")
+	operationKey        = tag.MustNewKey("operation")
+	requestOperationKey = tag.MustNewKey("request_operation")
+	resourceGroupKey    = tag.MustNewKey("resource_group")
+	resourceVersionKey  = tag.MustNewKey("resource_version")
+	resourceResourceKey = tag.MustNewKey("resource_resource")
+	informerKey         = tag.MustNewKey("informer")
+	labelKey            = tag.MustNewKey("label")
+)
+
+const (
+	// OperationDo tags the mutation that applies a projection
+	OperationDo = "Do"
+	// OperationUndo tags the mutation that removes a projection
+	OperationUndo = "Undo"
+)
+
+func init() {
+	if err := view.Register(
+		&view.View{
+			Description: serviceBindingReadyCountM.Description(),
+			Measure:     serviceBindingReadyCountM,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{statusKey, reasonKey},
+		},
+		&view.View{
+			Description: projectionMutationLatencyM.Description(),
+			Measure:     projectionMutationLatencyM,
+			Aggregation: view.Distribution(pkgmetrics.Buckets125(1, 10000)...), // [1 2 5 10 20 50 100 200 500 1000 2000 5000 10000]ms
+			TagKeys:     []tag.Key{operationKey, resourceGroupKey, resourceResourceKey},
+		},
+		&view.View{
+			Description: bindingAdmissionFailuresM.Description(),
+			Measure:     bindingAdmissionFailuresM,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{requestOperationKey, resourceGroupKey, resourceVersionKey, resourceResourceKey},
+		},
+		&view.View{
+			Description: projectionWorkloadCountM.Description(),
+			Measure:     projectionWorkloadCountM,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Description: resolverInformerCacheM.Description(),
+			Measure:     resolverInformerCacheM,
+			Aggregation: view.LastValue(),
+			TagKeys:     []tag.Key{informerKey, resourceGroupKey, resourceVersionKey, resourceResourceKey},
+		},
+		&view.View{
+			Description: legacyLabelCountM.Description(),
+			Measure:     legacyLabelCountM,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{labelKey, resourceGroupKey, resourceResourceKey},
+		},
+	); err != nil {
+		panic(err)
+	}
+}
+
+// ReadyReporter counts ServiceBindings by the status and reason of their
+// Ready condition. Each ServiceBinding is counted once, under the most
+// recently observed condition.
+type ReadyReporter struct {
+	m      sync.Mutex
+	ready  map[types.NamespacedName]readyState
+	counts map[readyState]int64
+}
+
+type readyState struct {
+	status string
+	reason string
+}
+
+// NewReadyReporter creates a ReadyReporter with no ServiceBindings counted.
+func NewReadyReporter() *ReadyReporter {
+	return &ReadyReporter{
+		ready:  map[types.NamespacedName]readyState{},
+		counts: map[readyState]int64{},
+	}
+}
+
+// Observe counts the ServiceBinding under the status and reason of its Ready
+// condition, instead of any previously observed condition.
+func (r *ReadyReporter) Observe(ctx context.Context, key types.NamespacedName, status, reason string) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	state := readyState{status: status, reason: reason}
+	prev, ok := r.ready[key]
+	if ok && prev == state {
+		return
+	}
+	r.ready[key] = state
+	r.counts[state]++
+	r.record(ctx, state)
+	if ok {
+		r.counts[prev]--
+		r.record(ctx, prev)
+	}
+}
+
+// Forget stops counting a ServiceBinding that no longer exists.
+func (r *ReadyReporter) Forget(ctx context.Context, key types.NamespacedName) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	prev, ok := r.ready[key]
+	if !ok {
+		return
+	}
+	delete(r.ready, key)
+	r.counts[prev]--
+	r.record(ctx, prev)
+}
+
+func (r *ReadyReporter) record(ctx context.Context, state readyState) {
+	count := r.counts[state]
+	if count == 0 {
+		// the zero is still recorded, so the series does not keep reporting
+		// the last non zero count
+		delete(r.counts, state)
+	}
+	ctx, err := tag.New(ctx,
+		tag.Insert(statusKey, state.status),
+		tag.Insert(reasonKey, state.reason),
+	)
+	if err != nil {
+		return
+	}
+	pkgmetrics.Record(ctx, serviceBindingReadyCountM.M(count))
+}
+
+// WorkloadsReporter counts the workloads bound by ServiceBindingProjections.
+// Each ServiceBindingProjection is counted once, with the most recently
+// observed number of bound workloads.
+type WorkloadsReporter struct {
+	m         sync.Mutex
+	workloads map[types.NamespacedName]int64
+	total     int64
+}
+
+// NewWorkloadsReporter creates a WorkloadsReporter with no
+// ServiceBindingProjections counted.
+func NewWorkloadsReporter() *WorkloadsReporter {
+	return &WorkloadsReporter{
+		workloads: map[types.NamespacedName]int64{},
+	}
+}
+
+// Observe counts the workloads bound by the ServiceBindingProjection, instead
+// of any previously observed number.
+func (r *WorkloadsReporter) Observe(ctx context.Context, key types.NamespacedName, count int) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	prev, ok := r.workloads[key]
+	if ok && prev == int64(count) {
+		return
+	}
+	r.workloads[key] = int64(count)
+	r.total += int64(count) - prev
+	r.record(ctx)
+}
+
+// Forget stops counting a ServiceBindingProjection that no longer exists.
+func (r *WorkloadsReporter) Forget(ctx context.Context, key types.NamespacedName) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	prev, ok := r.workloads[key]
+	if !ok {
+		return
+	}
+	delete(r.workloads, key)
+	r.total -= prev
+	r.record(ctx)
+}
+
+func (r *WorkloadsReporter) record(ctx context.Context) {
+	pkgmetrics.Record(ctx, projectionWorkloadCountM.M(r.total))
+}
+
+// RecordProjectionMutation records the time taken to apply or remove a
+// projection for a resource in the binding webhook.
+func RecordProjectionMutation(ctx context.Context, operation string, gr schema.GroupResource, d time.Duration) {
+	ctx, err := tag.New(ctx,
+		tag.Insert(operationKey, operation),
+		tag.Insert(resourceGroupKey, gr.Group),
+		tag.Insert(resourceResourceKey, gr.Resource),
+	)
+	if err != nil {
+		return
+	}
+	// Convert time.Duration in nanoseconds to milliseconds
+	pkgmetrics.Record(ctx, projectionMutationLatencyM.M(float64(d.Microseconds())/1000))
+}
+
+// RecordAdmissionFailure records an admission request the binding webhook
+// failed to mutate.
+func RecordAdmissionFailure(ctx context.Context, operation string, gvr schema.GroupVersionResource) {
+	ctx, err := tag.New(ctx,
+		tag.Insert(requestOperationKey, operation),
+		tag.Insert(resourceGroupKey, gvr.Group),
+		tag.Insert(resourceVersionKey, gvr.Version),
+		tag.Insert(resourceResourceKey, gvr.Resource),
+	)
+	if err != nil {
+		return
+	}
+	pkgmetrics.Record(ctx, bindingAdmissionFailuresM.M(1))
+}
+
+// RecordInformerCache records the number of resources cached by an informer
+// of the service resolver.
+func RecordInformerCache(ctx context.Context, informer string, gvr schema.GroupVersionResource, count int64) {
+	ctx, err := tag.New(ctx,
+		tag.Insert(informerKey, informer),
+		tag.Insert(resourceGroupKey, gvr.Group),
+		tag.Insert(resourceVersionKey, gvr.Version),
+		tag.Insert(resourceResourceKey, gvr.Resource),
+	)
+	if err != nil {
+		return
+	}
+	pkgmetrics.Record(ctx, resolverInformerCacheM.M(count))
+}
+
+// RecordLegacyLabel records a legacy selection label carried by a resource
+// admitted by the binding webhook, either the workload or its namespace.
+func RecordLegacyLabel(ctx context.Context, label string, gr schema.GroupResource) {
+	ctx, err := tag.New(ctx,
+		tag.Insert(labelKey, label),
+		tag.Insert(resourceGroupKey, gr.Group),
+		tag.Insert(resourceResourceKey, gr.Resource),
+	)
+	if err != nil {
+		return
+	}
+	pkgmetrics.Record(ctx, legacyLabelCountM.M(1))
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opencensus.io/stats/view"
+	"k8s.io/apimachinery/pkg/types"
+	_ "knative.dev/pkg/metrics/testing"
+)
+
+func TestReadyReporter(t *testing.T) {
+	first := types.NamespacedName{Namespace: "my-namespace", Name: "first"}
+	second := types.NamespacedName{Namespace: "my-namespace", Name: "second"}
+
+	type step struct {
+		key    types.NamespacedName
+		status string
+		reason string
+		forget bool
+	}
+
+	tests := []struct {
+		name     string
+		steps    []step
+		expected map[string]float64
+	}{
+		{
+			name: "observe",
+			steps: []step{
+				{key: first, status: "True", reason: "Ready"},
+				{key: second, status: "True", reason: "Ready"},
+			},
+			expected: map[string]float64{
+				"True/Ready": 2,
+			},
+		},
+		{
+			name: "observe again",
+			steps: []step{
+				{key: first, status: "True", reason: "Ready"},
+				{key: first, status: "True", reason: "Ready"},
+			},
+			expected: map[string]float64{
+				"True/Ready": 1,
+			},
+		},
+		{
+			name: "transition",
+			steps: []step{
+				{key: first, status: "Unknown", reason: "ServiceAvailableUnknown"},
+				{key: second, status: "Unknown", reason: "ServiceAvailableUnknown"},
+				{key: first, status: "False", reason: "ServiceAvailableSecretNotFound"},
+			},
+			expected: map[string]float64{
+				"Unknown/ServiceAvailableUnknown":      1,
+				"False/ServiceAvailableSecretNotFound": 1,
+			},
+		},
+		{
+			name: "transition back to zero",
+			steps: []step{
+				{key: first, status: "False", reason: "ServiceAvailableSecretNotFound"},
+				{key: first, status: "True", reason: "Ready"},
+			},
+			expected: map[string]float64{
+				"False/ServiceAvailableSecretNotFound": 0,
+				"True/Ready":                           1,
+			},
+		},
+		{
+			name: "forget",
+			steps: []step{
+				{key: first, status: "True", reason: "Ready"},
+				{key: second, status: "True", reason: "Ready"},
+				{key: first, forget: true},
+				{key: first, forget: true},
+			},
+			expected: map[string]float64{
+				"True/Ready": 1,
+			},
+		},
+		{
+			name: "forget unknown",
+			steps: []step{
+				{key: first, forget: true},
+			},
+			expected: map[string]float64{},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			resetView(t, serviceBindingReadyCountName)
+
+			ctx := context.TODO()
+			reporter := NewReadyReporter()
+			for _, s := range c.steps {
+				if s.forget {
+					reporter.Forget(ctx, s.key)
+				} else {
+					reporter.Observe(ctx, s.key, s.status, s.reason)
+				}
+			}
+
+			rows, err := view.RetrieveData(serviceBindingReadyCountName)
+			if err != nil {
+				t.Fatalf("RetrieveData() unexpected err %v", err)
+			}
+			actual := map[string]float64{}
+			for _, row := range rows {
+				tags := map[string]string{}
+				for _, tag := range row.Tags {
+					tags[tag.Key.Name()] = tag.Value
+				}
+				actual[tags["status"]+"/"+tags["reason"]] = row.Data.(*view.LastValueData).Value
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ReadyReporter (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadsReporter(t *testing.T) {
+	first := types.NamespacedName{Namespace: "my-namespace", Name: "first"}
+	second := types.NamespacedName{Namespace: "my-namespace", Name: "second"}
+
+	type step struct {
+		key    types.NamespacedName
+		count  int
+		forget bool
+	}
+
+	tests := []struct {
+		name     string
+		steps    []step
+		expected []float64
+	}{
+		{
+			name: "observe",
+			steps: []step{
+				{key: first, count: 1},
+				{key: second, count: 2},
+			},
+			expected: []float64{3},
+		},
+		{
+			name: "observe again",
+			steps: []step{
+				{key: first, count: 2},
+				{key: first, count: 1},
+			},
+			expected: []float64{1},
+		},
+		{
+			name: "forget",
+			steps: []step{
+				{key: first, count: 1},
+				{key: second, count: 2},
+				{key: first, forget: true},
+				{key: first, forget: true},
+			},
+			expected: []float64{2},
+		},
+		{
+			name: "forget back to zero",
+			steps: []step{
+				{key: first, count: 1},
+				{key: first, forget: true},
+			},
+			expected: []float64{0},
+		},
+		{
+			name: "forget unknown",
+			steps: []step{
+				{key: first, forget: true},
+			},
+			expected: []float64{},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			resetView(t, projectionWorkloadCountName)
+
+			ctx := context.TODO()
+			reporter := NewWorkloadsReporter()
+			for _, s := range c.steps {
+				if s.forget {
+					reporter.Forget(ctx, s.key)
+				} else {
+					reporter.Observe(ctx, s.key, s.count)
+				}
+			}
+
+			rows, err := view.RetrieveData(projectionWorkloadCountName)
+			if err != nil {
+				t.Fatalf("RetrieveData() unexpected err %v", err)
+			}
+			actual := []float64{}
+			for _, row := range rows {
+				if len(row.Tags) != 0 {
+					t.Errorf("WorkloadsReporter unexpected tags %v", row.Tags)
+				}
+				actual = append(actual, row.Data.(*view.LastValueData).Value)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("WorkloadsReporter (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+// resetView clears the data recorded for the view.
+func resetView(t *testing.T, name string) {
+	v := view.Find(name)
+	view.Unregister(v)
+	if err := view.Register(v); err != nil {
+		t.Fatalf("Register() unexpected err %v", err)
+	}
+}
@@ -0,0 +1,446 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package projector
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"knative.dev/pkg/apis/duck"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/tracker"
+
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebinding/resources"
+	resourcenames "github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebinding/resources/names"
+	bindingwebhook "github.com/vmware-tanzu/servicebinding/pkg/webhook/binding"
+)
+
+// Projection is a workload resource along with the result of applying the
+// matching ServiceBindings to it.
+type Projection struct {
+	// Original is the workload as it was read
+	Original *unstructured.Unstructured
+	// Projected is the workload with the bindings applied
+	Projected *unstructured.Unstructured
+	// Template is the pod template of the workload with the bindings applied
+	Template duckv1.PodSpecable
+	// Bindings are the names of the ServiceBindings applied to the workload
+	Bindings []string
+}
+
+// Decode reads every resource from a stream of YAML or JSON documents. Lists
+// are flattened into their items.
+func Decode(r io.Reader) ([]*unstructured.Unstructured, error) {
+	objs := []*unstructured.Unstructured{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(r), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, fmt.Errorf("unable to decode resource: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			// empty document
+			continue
+		}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode list: %w", err)
+			}
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+			continue
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// ResourceMapper resolves the resource serving a kind, for example a
+// resolver.ResourceMapper reading the discovery information of the cluster.
+type ResourceMapper interface {
+	ResourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error)
+}
+
+// NewOfflineResourceMapper creates a ResourceMapper for use without a
+// cluster. Custom resources are resolved from the CustomResourceDefinitions in
+// objs, the resource of other kinds is derived from the kind, which holds for
+// the built-in kinds.
+func NewOfflineResourceMapper(objs []*unstructured.Unstructured) ResourceMapper {
+	m := offlineResourceMapper{}
+	for _, obj := range objs {
+		if obj.GroupVersionKind().GroupKind() != (schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}) {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+		plural, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "plural")
+		if kind != "" && plural != "" {
+			m[schema.GroupKind{Group: group, Kind: kind}] = plural
+		}
+	}
+	return m
+}
+
+// offlineResourceMapper holds the plural resource name of custom kinds
+type offlineResourceMapper map[schema.GroupKind]string
+
+func (m offlineResourceMapper) ResourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	if plural, ok := m[gvk.GroupKind()]; ok {
+		return gvk.GroupVersion().WithResource(plural), nil
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+	return gvr, nil
+}
+
+// Project applies the ServiceBindings found in objs to the workload resources
+// found in objs, using the same logic as the admission webhook. Resources
+// without a namespace are treated as being in the default namespace. The
+// mapper resolves the resource of each workload, to find the
+// ClusterWorkloadResourceMapping that describes it.
+//
+// Without a cluster, the binding Secret of a service is resolved from the
+// service resources in objs and ServiceBindingGrants are not consulted for
+// services in another namespace.
+func Project(ctx context.Context, namespace string, objs []*unstructured.Unstructured, mapper ResourceMapper) ([]Projection, error) {
+	bindings := []*servicebindingv1alpha3.ServiceBinding{}
+	mappings := map[string]*servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		switch gvk.GroupKind() {
+		case servicebindingv1alpha3.Kind("ServiceBinding"):
+			binding, err := toServiceBinding(ctx, obj, namespace)
+			if err != nil {
+				return nil, err
+			}
+			bindings = append(bindings, binding)
+		case servicebindingv1beta1.Kind("ClusterWorkloadResourceMapping"):
+			mapping := &servicebindingv1beta1.ClusterWorkloadResourceMapping{}
+			if err := fromUnstructured(obj, mapping); err != nil {
+				return nil, fmt.Errorf("unable to decode ClusterWorkloadResourceMapping %q: %w", obj.GetName(), err)
+			}
+			mapping.SetDefaults(ctx)
+			if err := mapping.Validate(ctx); err != nil {
+				return nil, fmt.Errorf("invalid ClusterWorkloadResourceMapping %q: %w", mapping.Name, err)
+			}
+			mappings[mapping.Name] = mapping
+		}
+	}
+
+	projections := []*labsinternalv1alpha1.ServiceBindingProjection{}
+	for _, binding := range bindings {
+		secretRef, err := bindingSecret(binding, namespace, objs)
+		if err != nil {
+			return nil, err
+		}
+		binding.Status.Binding = &corev1.LocalObjectReference{Name: secretRef.Name}
+		if secretRef.Kind == labsinternalv1alpha1.BindingKindConfigMap {
+			if len(binding.Spec.Mappings) != 0 {
+				return nil, fmt.Errorf("binding ConfigMap %q of ServiceBinding %q can not be mapped", secretRef.Name, binding.Name)
+			}
+			binding.Status.BindingKind = secretRef.Kind
+		}
+		binding.Status.Services, err = boundServices(binding, namespace, objs)
+		if err != nil {
+			return nil, err
+		}
+		projection, err := resources.MakeServiceBindingProjection(binding)
+		if err != nil {
+			return nil, err
+		}
+		projections = append(projections, projection)
+	}
+
+	results := []Projection{}
+	for _, obj := range objs {
+		matched := []*labsinternalv1alpha1.ServiceBindingProjection{}
+		for _, projection := range projections {
+			ok, err := matches(projection.GetSubject(), obj, namespace)
+			if err != nil {
+				return nil, fmt.Errorf("invalid workload for ServiceBinding %q: %w", projection.Name, err)
+			}
+			if ok {
+				matched = append(matched, projection)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		projected, ps, err := project(ctx, obj, matched, mappings, mapper)
+		if err != nil {
+			return nil, fmt.Errorf("unable to project %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		result := Projection{
+			Original:  obj,
+			Projected: projected,
+			Template:  ps.Spec.Template,
+		}
+		for _, projection := range matched {
+			result.Bindings = append(result.Bindings, projection.Name)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func toServiceBinding(ctx context.Context, obj *unstructured.Unstructured, namespace string) (*servicebindingv1alpha3.ServiceBinding, error) {
+	binding := &servicebindingv1alpha3.ServiceBinding{}
+	switch obj.GetAPIVersion() {
+	case servicebindingv1alpha3.SchemeGroupVersion.String():
+		if err := fromUnstructured(obj, binding); err != nil {
+			return nil, fmt.Errorf("unable to decode ServiceBinding %q: %w", obj.GetName(), err)
+		}
+	case servicebindingv1beta1.SchemeGroupVersion.String():
+		source := &servicebindingv1beta1.ServiceBinding{}
+		if err := fromUnstructured(obj, source); err != nil {
+			return nil, fmt.Errorf("unable to decode ServiceBinding %q: %w", obj.GetName(), err)
+		}
+		if err := binding.ConvertFrom(ctx, source); err != nil {
+			return nil, fmt.Errorf("unable to convert ServiceBinding %q: %w", obj.GetName(), err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported ServiceBinding version %q", obj.GetAPIVersion())
+	}
+	binding.Namespace = namespaceOf(obj, namespace)
+	binding.SetDefaults(ctx)
+	if err := binding.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid ServiceBinding %q: %w", binding.Name, err)
+	}
+	return binding, nil
+}
+
+// namespaceOf returns the namespace of the resource, or the default namespace
+// when the resource does not set one.
+func namespaceOf(obj *unstructured.Unstructured, namespace string) string {
+	if ns := obj.GetNamespace(); ns != "" {
+		return ns
+	}
+	return namespace
+}
+
+// fromUnstructured decodes the resource the same way the API server does,
+// through its JSON representation.
+func fromUnstructured(obj *unstructured.Unstructured, into interface{}) error {
+	raw, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, into)
+}
+
+// bindingSecret resolves the Secret, or ConfigMap, the controller projects
+// into workloads for the binding.
+func bindingSecret(binding *servicebindingv1alpha3.ServiceBinding, namespace string, objs []*unstructured.Unstructured) (*corev1.TypedLocalObjectReference, error) {
+	serviceRef := binding.Spec.Service.DeepCopy()
+	if serviceRef.Namespace == "" {
+		serviceRef.Namespace = binding.Namespace
+	}
+	if len(binding.Spec.Fields) != 0 {
+		return &corev1.TypedLocalObjectReference{Kind: labsinternalv1alpha1.BindingKindSecret, Name: resourcenames.GeneratedSecret(binding)}, nil
+	}
+	if serviceRef.Namespace != binding.Namespace {
+		return &corev1.TypedLocalObjectReference{Kind: labsinternalv1alpha1.BindingKindSecret, Name: resourcenames.CopiedSecret(binding)}, nil
+	}
+	return serviceSecret(serviceRef, binding, namespace, objs)
+}
+
+// serviceSecret resolves the binding Secret, or ConfigMap, exposed by a
+// service in the namespace of the binding.
+func serviceSecret(serviceRef *tracker.Reference, binding *servicebindingv1alpha3.ServiceBinding, namespace string, objs []*unstructured.Unstructured) (*corev1.TypedLocalObjectReference, error) {
+	if serviceRef.APIVersion == "v1" && (serviceRef.Kind == labsinternalv1alpha1.BindingKindSecret || serviceRef.Kind == labsinternalv1alpha1.BindingKindConfigMap) {
+		return &corev1.TypedLocalObjectReference{Kind: serviceRef.Kind, Name: serviceRef.Name}, nil
+	}
+	for _, obj := range objs {
+		if obj.GetAPIVersion() != serviceRef.APIVersion || obj.GetKind() != serviceRef.Kind || namespaceOf(obj, namespace) != serviceRef.Namespace || obj.GetName() != serviceRef.Name {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(obj.Object, "status", "binding", "name"); name != "" {
+			kind, _, _ := unstructured.NestedString(obj.Object, "status", "binding", "kind")
+			switch kind {
+			case "":
+				kind = labsinternalv1alpha1.BindingKindSecret
+			case labsinternalv1alpha1.BindingKindSecret, labsinternalv1alpha1.BindingKindConfigMap:
+			default:
+				return nil, fmt.Errorf("%s %q referenced by ServiceBinding %q exposes a binding of unsupported kind %q", serviceRef.Kind, serviceRef.Name, binding.Name, kind)
+			}
+			return &corev1.TypedLocalObjectReference{Kind: kind, Name: name}, nil
+		}
+		if obj.GroupVersionKind().GroupKind() == labsv1alpha1.Kind("ProvisionedService") {
+			// the controller has not yet reflected the secret onto the status
+			if name, _, _ := unstructured.NestedString(obj.Object, "spec", "binding", "name"); name != "" {
+				return &corev1.TypedLocalObjectReference{Kind: labsinternalv1alpha1.BindingKindSecret, Name: name}, nil
+			}
+		}
+		return nil, fmt.Errorf("%s %q referenced by ServiceBinding %q does not expose a binding Secret", serviceRef.Kind, serviceRef.Name, binding.Name)
+	}
+	return nil, fmt.Errorf("%s %q referenced by ServiceBinding %q not found", serviceRef.Kind, serviceRef.Name, binding.Name)
+}
+
+// boundServices resolves the binding Secret of each of the additional
+// services of the binding.
+func boundServices(binding *servicebindingv1alpha3.ServiceBinding, namespace string, objs []*unstructured.Unstructured) ([]servicebindingv1alpha3.BoundServiceStatus, error) {
+	var statuses []servicebindingv1alpha3.BoundServiceStatus
+	for _, s := range binding.Spec.Services {
+		serviceRef := s.Service.DeepCopy()
+		serviceRef.Namespace = binding.Namespace
+		secretRef, err := serviceSecret(serviceRef, binding, namespace, objs)
+		if err != nil {
+			return nil, err
+		}
+		if secretRef.Kind != labsinternalv1alpha1.BindingKindSecret {
+			return nil, fmt.Errorf("%s %q referenced by ServiceBinding %q does not expose a binding Secret", serviceRef.Kind, serviceRef.Name, binding.Name)
+		}
+		statuses = append(statuses, servicebindingv1alpha3.BoundServiceStatus{
+			Name:      s.Name,
+			Available: true,
+			Binding:   &corev1.LocalObjectReference{Name: secretRef.Name},
+		})
+	}
+	return statuses, nil
+}
+
+// matches returns true when the workload is the subject of a binding, by name
+// or by label selector.
+func matches(subject tracker.Reference, obj *unstructured.Unstructured, namespace string) (bool, error) {
+	gv, err := schema.ParseGroupVersion(subject.APIVersion)
+	if err != nil {
+		return false, err
+	}
+	if gv.Group != obj.GroupVersionKind().Group || subject.Kind != obj.GetKind() || subject.Namespace != namespaceOf(obj, namespace) {
+		return false, nil
+	}
+	if subject.Name != "" {
+		return subject.Name == obj.GetName(), nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(subject.Selector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(obj.GetLabels())), nil
+}
+
+// project applies the bindings to the workload. Workloads described by a
+// ClusterWorkloadResourceMapping are mapped, Pods are bound as when they are
+// created, others are treated as PodSpecable. The projected pod spec is returned along with the workload.
+func project(ctx context.Context, obj *unstructured.Unstructured, projections []*labsinternalv1alpha1.ServiceBindingProjection, mappings map[string]*servicebindingv1beta1.ClusterWorkloadResourceMapping, mapper ResourceMapper) (*unstructured.Unstructured, *duckv1.WithPod, error) {
+	gvr, err := mapper.ResourceFor(obj.GroupVersionKind())
+	if err != nil {
+		return nil, nil, err
+	}
+	if mapping, ok := mappings[gvr.GroupResource().String()]; ok {
+		if template := mapping.LookupTemplate(gvr.Version); template != nil {
+			ps, err := template.ExtractPodSpecable(obj)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, projection := range projections {
+				projection.Do(ctx, ps)
+			}
+			projected := obj.DeepCopy()
+			if err := template.InjectPodSpecable(projected, ps); err != nil {
+				return nil, nil, err
+			}
+			return projected, ps, nil
+		}
+	}
+
+	if bindingwebhook.IsPod(gvr.GroupResource()) {
+		return projectPod(ctx, obj, projections)
+	}
+
+	obj = obj.DeepCopy()
+	if _, ok, _ := unstructured.NestedMap(obj.Object, "spec", "template", "metadata"); !ok {
+		// the API server always persists the metadata of the pod template,
+		// the patch may add annotations to it
+		if err := unstructured.SetNestedMap(obj.Object, map[string]interface{}{}, "spec", "template", "metadata"); err != nil {
+			return nil, nil, err
+		}
+	}
+	raw, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+	orig := &duckv1.WithPod{}
+	if err := json.Unmarshal(raw, orig); err != nil {
+		return nil, nil, err
+	}
+	ps := orig.DeepCopy()
+	for _, projection := range projections {
+		projection.Do(ctx, ps)
+	}
+	// apply the changes as a patch, like the webhook, to preserve the fields
+	// that are not part of the PodSpecable duck type
+	projected, err := applyPatch(raw, orig, ps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return projected, ps, nil
+}
+
+// projectPod applies the bindings to a Pod, as the webhook does when the Pod
+// is created.
+func projectPod(ctx context.Context, obj *unstructured.Unstructured, projections []*labsinternalv1alpha1.ServiceBindingProjection) (*unstructured.Unstructured, *duckv1.WithPod, error) {
+	raw, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(raw, pod); err != nil {
+		return nil, nil, err
+	}
+	ps := bindingwebhook.ExtractPod(pod)
+	for _, projection := range projections {
+		projection.Do(ctx, ps)
+	}
+	mutated := pod.DeepCopy()
+	bindingwebhook.InjectPod(mutated, ps)
+	projected, err := applyPatch(raw, pod, mutated)
+	if err != nil {
+		return nil, nil, err
+	}
+	return projected, ps, nil
+}
+
+// applyPatch applies the changes between orig and mutated to the raw
+// resource.
+func applyPatch(raw []byte, orig, mutated interface{}) (*unstructured.Unstructured, error) {
+	patchBytes, err := duck.CreateBytePatch(orig, mutated)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.DecodePatch(patchBytes)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := patch.Apply(raw)
+	if err != nil {
+		return nil, err
+	}
+	projected := &unstructured.Unstructured{}
+	if err := projected.UnmarshalJSON(patched); err != nil {
+		return nil, err
+	}
+	return projected, nil
+}
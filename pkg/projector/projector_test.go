@@ -0,0 +1,429 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package projector
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const bindingYAML = `
+apiVersion: servicebinding.io/v1beta1
+kind: ServiceBinding
+metadata:
+  name: my-binding
+spec:
+  service:
+    apiVersion: bindings.labs.vmware.com/v1alpha1
+    kind: ProvisionedService
+    name: my-service
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-workload
+`
+
+const provisionedServiceYAML = `
+apiVersion: bindings.labs.vmware.com/v1alpha1
+kind: ProvisionedService
+metadata:
+  name: my-service
+spec:
+  binding:
+    name: my-secret
+`
+
+const deploymentYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-workload
+  labels:
+    app: my-app
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: app
+        image: my-image
+`
+
+func mustDecode(t *testing.T, docs ...string) []*unstructured.Unstructured {
+	t.Helper()
+	objs, err := Decode(strings.NewReader(strings.Join(docs, "\n---\n")))
+	if err != nil {
+		t.Fatalf("Decode() unexpected err %v", err)
+	}
+	return objs
+}
+
+func TestDecode(t *testing.T) {
+	objs := mustDecode(t, "", deploymentYAML, `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Secret
+  metadata:
+    name: my-secret
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: my-config
+`)
+	actual := []string{}
+	for _, obj := range objs {
+		actual = append(actual, obj.GetKind()+"/"+obj.GetName())
+	}
+	expected := []string{"Deployment/my-workload", "Secret/my-secret", "ConfigMap/my-config"}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("Decode() (-expected, +actual): %s", diff)
+	}
+}
+
+func TestProject(t *testing.T) {
+	tests := []struct {
+		name             string
+		docs             []string
+		expectedBindings []string
+		expectedSecrets  []string
+		volumesPath      []string
+		expectedErr      bool
+	}{
+		{
+			name:             "provisioned service",
+			docs:             []string{bindingYAML, provisionedServiceYAML, deploymentYAML},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-secret"},
+			volumesPath:      []string{"spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "provisioned service status",
+			docs: []string{bindingYAML, provisionedServiceYAML + `
+status:
+  binding:
+    name: my-status-secret
+`, deploymentYAML},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-status-secret"},
+			volumesPath:      []string{"spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "secret reference and label selector",
+			docs: []string{`
+apiVersion: servicebinding.io/v1alpha3
+kind: ServiceBinding
+metadata:
+  name: my-binding
+spec:
+  service:
+    apiVersion: v1
+    kind: Secret
+    name: my-direct-secret
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    selector:
+      matchLabels:
+        app: my-app
+`, deploymentYAML},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-direct-secret"},
+			volumesPath:      []string{"spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "config map",
+			docs: []string{bindingYAML, provisionedServiceYAML + `
+status:
+  binding:
+    name: my-config-map
+    kind: ConfigMap
+`, deploymentYAML},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-config-map"},
+			volumesPath:      []string{"spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "generated secret",
+			docs: []string{bindingYAML + `
+  fields:
+  - key: host
+    jsonPath: .status.host
+`, deploymentYAML},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-binding-generated"},
+			volumesPath:      []string{"spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "other namespace",
+			docs: []string{bindingYAML, provisionedServiceYAML, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-workload
+  namespace: other-namespace
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+`},
+		},
+		{
+			name: "mapped workload",
+			docs: []string{`
+apiVersion: servicebinding.io/v1beta1
+kind: ServiceBinding
+metadata:
+  name: my-binding
+spec:
+  service:
+    apiVersion: v1
+    kind: Secret
+    name: my-secret
+  workload:
+    apiVersion: batch/v1beta1
+    kind: CronJob
+    name: my-cronjob
+`, `
+apiVersion: servicebinding.io/v1beta1
+kind: ClusterWorkloadResourceMapping
+metadata:
+  name: cronjobs.batch
+spec:
+  versions:
+  - version: "*"
+    annotations: .spec.jobTemplate.spec.template.metadata.annotations
+    containers:
+    - path: .spec.jobTemplate.spec.template.spec.containers[*]
+      name: .name
+    volumes: .spec.jobTemplate.spec.template.spec.volumes
+`, `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: my-cronjob
+spec:
+  schedule: "@hourly"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: app
+`},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-secret"},
+			volumesPath:      []string{"spec", "jobTemplate", "spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "mapped custom workload",
+			docs: []string{`
+apiVersion: servicebinding.io/v1beta1
+kind: ServiceBinding
+metadata:
+  name: my-binding
+spec:
+  service:
+    apiVersion: v1
+    kind: Secret
+    name: my-secret
+  workload:
+    apiVersion: example.com/v1
+    kind: Cactus
+    name: my-cactus
+`, `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: cacti.example.com
+spec:
+  group: example.com
+  names:
+    kind: Cactus
+    plural: cacti
+  scope: Namespaced
+`, `
+apiVersion: servicebinding.io/v1beta1
+kind: ClusterWorkloadResourceMapping
+metadata:
+  name: cacti.example.com
+spec:
+  versions:
+  - version: "*"
+    annotations: .spec.annotations
+    containers:
+    - path: .spec.containers[*]
+      name: .name
+    volumes: .spec.volumes
+`, `
+apiVersion: example.com/v1
+kind: Cactus
+metadata:
+  name: my-cactus
+spec:
+  containers:
+  - name: app
+`},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-secret"},
+			volumesPath:      []string{"spec", "volumes"},
+		},
+		{
+			name: "pod",
+			docs: []string{`
+apiVersion: servicebinding.io/v1beta1
+kind: ServiceBinding
+metadata:
+  name: my-binding
+spec:
+  service:
+    apiVersion: v1
+    kind: Secret
+    name: my-secret
+  workload:
+    apiVersion: v1
+    kind: Pod
+    selector:
+      matchLabels:
+        app: my-app
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: my-pod
+  labels:
+    app: my-app
+spec:
+  restartPolicy: Never
+  containers:
+  - name: app
+`},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-secret"},
+			volumesPath:      []string{"spec", "volumes"},
+		},
+		{
+			name: "services",
+			docs: []string{bindingYAML + `
+  services:
+  - name: my-cache
+    type: redis
+    service:
+      apiVersion: v1
+      kind: Secret
+      name: my-cache-secret
+`, provisionedServiceYAML, deploymentYAML},
+			expectedBindings: []string{"my-binding"},
+			expectedSecrets:  []string{"my-secret", "my-cache-secret"},
+			volumesPath:      []string{"spec", "template", "spec", "volumes"},
+		},
+		{
+			name: "missing services",
+			docs: []string{bindingYAML + `
+  services:
+  - name: my-cache
+    service:
+      apiVersion: bindings.labs.vmware.com/v1alpha1
+      kind: ProvisionedService
+      name: my-cache
+`, provisionedServiceYAML, deploymentYAML},
+			expectedErr: true,
+		},
+		{
+			name:        "missing service",
+			docs:        []string{bindingYAML, deploymentYAML},
+			expectedErr: true,
+		},
+		{
+			name: "invalid binding",
+			docs: []string{`
+apiVersion: servicebinding.io/v1beta1
+kind: ServiceBinding
+metadata:
+  name: my-binding
+spec:
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-workload
+`, deploymentYAML},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			objs := mustDecode(t, c.docs...)
+			originals := []*unstructured.Unstructured{}
+			for _, obj := range objs {
+				originals = append(originals, obj.DeepCopy())
+			}
+
+			actual, err := Project(context.TODO(), "my-namespace", objs, NewOfflineResourceMapper(objs))
+			if (err != nil) != c.expectedErr {
+				t.Errorf("Project() expected err %v, got %v", c.expectedErr, err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(originals, objs); diff != "" {
+				t.Errorf("Project() mutated input (-expected, +actual): %s", diff)
+			}
+			if len(c.expectedBindings) == 0 {
+				if len(actual) != 0 {
+					t.Errorf("Project() expected no projections, got %d", len(actual))
+				}
+				return
+			}
+			if len(actual) != 1 {
+				t.Fatalf("Project() expected 1 projection, got %d", len(actual))
+			}
+			if diff := cmp.Diff(c.expectedBindings, actual[0].Bindings); diff != "" {
+				t.Errorf("Project() bindings (-expected, +actual): %s", diff)
+			}
+			volumes, _, _ := unstructured.NestedSlice(actual[0].Projected.Object, c.volumesPath...)
+			secrets := []string{}
+			for _, v := range volumes {
+				sources, _, _ := unstructured.NestedSlice(v.(map[string]interface{}), "projected", "sources")
+				for _, s := range sources {
+					if name, ok, _ := unstructured.NestedString(s.(map[string]interface{}), "secret", "name"); ok {
+						secrets = append(secrets, name)
+					}
+					if name, ok, _ := unstructured.NestedString(s.(map[string]interface{}), "configMap", "name"); ok {
+						secrets = append(secrets, name)
+					}
+				}
+			}
+			if diff := cmp.Diff(c.expectedSecrets, secrets); diff != "" {
+				t.Errorf("Project() projected secrets (-expected, +actual): %s", diff)
+			}
+			templateSecrets := []string{}
+			for _, v := range actual[0].Template.Spec.Volumes {
+				if source := v.Projected.Sources[0]; source.ConfigMap != nil {
+					templateSecrets = append(templateSecrets, source.ConfigMap.Name)
+				} else {
+					templateSecrets = append(templateSecrets, source.Secret.Name)
+				}
+			}
+			if diff := cmp.Diff(c.expectedSecrets, templateSecrets); diff != "" {
+				t.Errorf("Project() template secrets (-expected, +actual): %s", diff)
+			}
+			// fields outside of the PodSpecable are preserved
+			delete(actual[0].Projected.Object, "spec")
+			delete(actual[0].Projected.Object, "metadata")
+			delete(actual[0].Original.Object, "spec")
+			delete(actual[0].Original.Object, "metadata")
+			if diff := cmp.Diff(actual[0].Original, actual[0].Projected); diff != "" {
+				t.Errorf("Project() (-original, +projected): %s", diff)
+			}
+		})
+	}
+}
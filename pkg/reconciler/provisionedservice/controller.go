@@ -8,10 +8,17 @@ package provisionedservice
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/tracker"
 
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	provisionedserviceinformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labs/v1alpha1/provisionedservice"
 	provisionedservicereconciler "github.com/vmware-tanzu/servicebinding/pkg/client/injection/reconciler/labs/v1alpha1/provisionedservice"
 )
@@ -24,13 +31,33 @@ func NewController(
 	logger := logging.FromContext(ctx)
 
 	provisionedserviceInformer := provisionedserviceinformer.Get(ctx)
-
-	r := &Reconciler{}
+	secretInformer := secretinformer.Get(ctx)
+	configMapInformer := configmapinformer.Get(ctx)
+
+	r := &Reconciler{
+		kubeclient:      kubeclient.Get(ctx),
+		secretLister:    secretInformer.Lister(),
+		configMapLister: configMapInformer.Lister(),
+	}
 	impl := provisionedservicereconciler.NewImpl(ctx, r)
+	r.tracker = tracker.New(impl.EnqueueKey, controller.GetTrackerLease(ctx))
 
 	logger.Info("Setting up event handlers.")
 
 	provisionedserviceInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGK(labsv1alpha1.Kind("ProvisionedService")),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	// the sources merged into the binding Secret are tracked
+	secretInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(r.tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("Secret")),
+	))
+	configMapInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(r.tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+	))
+
 	return impl
 }
@@ -19,12 +19,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgotesting "k8s.io/client-go/testing"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
 
 	// register injection fakes
 	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labs/v1alpha1/provisionedservice/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/fake"
 
 	. "github.com/vmware-tanzu/servicebinding/pkg/reconciler/testing"
 	. "knative.dev/pkg/reconciler/testing"
@@ -48,6 +52,83 @@ func TestReconcile(t *testing.T) {
 	binding := corev1.LocalObjectReference{
 		Name: "my-binding",
 	}
+	bindingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-binding",
+		},
+		Data: map[string][]byte{
+			"type": []byte("mysql"),
+		},
+	}
+	sources := []labsv1alpha1.BindingSource{
+		{
+			Secret: &corev1.LocalObjectReference{Name: "my-credentials"},
+		},
+		{
+			ConfigMap: &corev1.LocalObjectReference{Name: "my-config"},
+			Items: []labsv1alpha1.BindingSourceItem{
+				{Key: "hostname", Name: "host"},
+				{Key: "type"},
+			},
+		},
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-credentials",
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("secret"),
+			"host":     []byte("ignored.example.com"),
+		},
+	}
+	config := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-config",
+		},
+		Data: map[string]string{
+			"hostname": "db.example.com",
+			"type":     "postgresql",
+			"other":    "ignored",
+		},
+	}
+	mergedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-service-merged",
+			Labels: map[string]string{
+				"bindings.labs.vmware.com/provisionedservice": name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "bindings.labs.vmware.com/v1alpha1",
+					Kind:               "ProvisionedService",
+					Name:               name,
+					BlockOwnerDeletion: ptr.Bool(true),
+					Controller:         ptr.Bool(true),
+				},
+			},
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("secret"),
+			"host":     []byte("db.example.com"),
+			"type":     []byte("postgresql"),
+		},
+	}
+	mergedService := &labsv1alpha1.ProvisionedService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       name,
+			Generation: 1,
+		},
+		Spec: labsv1alpha1.ProvisionedServiceSpec{
+			Sources: sources,
+		},
+	}
 
 	table := TableTest{{
 		Name: "bad workqueue key",
@@ -74,6 +155,7 @@ func TestReconcile(t *testing.T) {
 		Name: "nop - in sync",
 		Key:  key,
 		Objects: []runtime.Object{
+			bindingSecret.DeepCopy(),
 			&labsv1alpha1.ProvisionedService{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
@@ -104,6 +186,287 @@ func TestReconcile(t *testing.T) {
 		Name: "reflect binding on status",
 		Key:  key,
 		Objects: []runtime.Object{
+			bindingSecret.DeepCopy(),
+			&labsv1alpha1.ProvisionedService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: labsv1alpha1.ProvisionedServiceSpec{
+					Binding: binding,
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &labsv1alpha1.ProvisionedService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: labsv1alpha1.ProvisionedServiceSpec{
+					Binding: binding,
+				},
+				Status: labsv1alpha1.ProvisionedServiceStatus{
+					Binding: binding,
+					Status: duckv1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1.Conditions{
+							{
+								Type:   labsv1alpha1.ProvisionedServiceConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ProvisionedService reconciled: %q", key),
+		},
+	}, {
+		Name: "binding secret not found",
+		Key:  key,
+		Objects: []runtime.Object{
+			&labsv1alpha1.ProvisionedService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: labsv1alpha1.ProvisionedServiceSpec{
+					Binding: binding,
+				},
+				Status: labsv1alpha1.ProvisionedServiceStatus{
+					Binding: binding,
+					Status: duckv1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1.Conditions{
+							{
+								Type:   labsv1alpha1.ProvisionedServiceConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &labsv1alpha1.ProvisionedService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: labsv1alpha1.ProvisionedServiceSpec{
+					Binding: binding,
+				},
+				Status: labsv1alpha1.ProvisionedServiceStatus{
+					Status: duckv1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1.Conditions{
+							{
+								Type:    labsv1alpha1.ProvisionedServiceConditionReady,
+								Status:  corev1.ConditionFalse,
+								Reason:  "SecretNotFound",
+								Message: `Secret "my-binding" not found`,
+							},
+						},
+					},
+				},
+			},
+		}},
+	}, {
+		Name: "binding secret missing type",
+		Key:  key,
+		Objects: []runtime.Object{
+			&labsv1alpha1.ProvisionedService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: labsv1alpha1.ProvisionedServiceSpec{
+					Binding: binding,
+				},
+			},
+			func() *corev1.Secret {
+				s := bindingSecret.DeepCopy()
+				delete(s.Data, "type")
+				return s
+			}(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &labsv1alpha1.ProvisionedService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Generation: 1,
+				},
+				Spec: labsv1alpha1.ProvisionedServiceSpec{
+					Binding: binding,
+				},
+				Status: labsv1alpha1.ProvisionedServiceStatus{
+					Status: duckv1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1.Conditions{
+							{
+								Type:    labsv1alpha1.ProvisionedServiceConditionReady,
+								Status:  corev1.ConditionFalse,
+								Reason:  "MissingType",
+								Message: `Secret "my-binding" is missing the required "type" entry`,
+							},
+						},
+					},
+				},
+			},
+		}},
+	}, {
+		Name: "creates merged secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			mergedService.DeepCopy(),
+			credentials.DeepCopy(),
+			config.DeepCopy(),
+		},
+		WantCreates: []runtime.Object{
+			mergedSecret.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *labsv1alpha1.ProvisionedService {
+				s := mergedService.DeepCopy()
+				s.Status.ObservedGeneration = 1
+				s.Status.Binding = corev1.LocalObjectReference{Name: "my-service-merged"}
+				s.Status.MarkReady()
+				return s
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Created", "Created Secret %q", "my-service-merged"),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ProvisionedService reconciled: %q", key),
+		},
+	}, {
+		Name: "updates merged secret when sources change",
+		Key:  key,
+		Objects: []runtime.Object{
+			func() *labsv1alpha1.ProvisionedService {
+				s := mergedService.DeepCopy()
+				s.Status.ObservedGeneration = 1
+				s.Status.Binding = corev1.LocalObjectReference{Name: "my-service-merged"}
+				s.Status.MarkReady()
+				return s
+			}(),
+			func() *corev1.Secret {
+				s := credentials.DeepCopy()
+				s.Data["password"] = []byte("rotated")
+				return s
+			}(),
+			config.DeepCopy(),
+			mergedSecret.DeepCopy(),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *corev1.Secret {
+				s := mergedSecret.DeepCopy()
+				s.Data["password"] = []byte("rotated")
+				return s
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ProvisionedService reconciled: %q", key),
+		},
+	}, {
+		Name: "source not found",
+		Key:  key,
+		Objects: []runtime.Object{
+			mergedService.DeepCopy(),
+			credentials.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *labsv1alpha1.ProvisionedService {
+				s := mergedService.DeepCopy()
+				s.Status.ObservedGeneration = 1
+				s.Status.MarkFailed("SourceNotFound", `ConfigMap "my-config" not found`)
+				return s
+			}(),
+		}},
+	}, {
+		Name: "source key not found",
+		Key:  key,
+		Objects: []runtime.Object{
+			mergedService.DeepCopy(),
+			credentials.DeepCopy(),
+			func() *corev1.ConfigMap {
+				c := config.DeepCopy()
+				delete(c.Data, "type")
+				return c
+			}(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *labsv1alpha1.ProvisionedService {
+				s := mergedService.DeepCopy()
+				s.Status.ObservedGeneration = 1
+				s.Status.MarkFailed("KeyNotFound", `key "type" not found in ConfigMap "my-config"`)
+				return s
+			}(),
+		}},
+	}, {
+		Name: "merged secret missing type",
+		Key:  key,
+		Objects: []runtime.Object{
+			mergedService.DeepCopy(),
+			credentials.DeepCopy(),
+			func() *corev1.ConfigMap {
+				c := config.DeepCopy()
+				c.Data["type"] = ""
+				return c
+			}(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *labsv1alpha1.ProvisionedService {
+				s := mergedService.DeepCopy()
+				s.Status.ObservedGeneration = 1
+				s.Status.MarkFailed("MissingType", `Secret "my-service-merged" is missing the required "type" entry`)
+				return s
+			}(),
+		}},
+	}, {
+		Name: "merged secret not owned",
+		Key:  key,
+		Objects: []runtime.Object{
+			mergedService.DeepCopy(),
+			credentials.DeepCopy(),
+			config.DeepCopy(),
+			func() *corev1.Secret {
+				s := mergedSecret.DeepCopy()
+				s.OwnerReferences = nil
+				return s
+			}(),
+		},
+		WantErr: true,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *labsv1alpha1.ProvisionedService {
+				s := mergedService.DeepCopy()
+				s.Status.ObservedGeneration = 1
+				s.Status.Conditions = duckv1.Conditions{
+					{
+						Type:    labsv1alpha1.ProvisionedServiceConditionReady,
+						Status:  corev1.ConditionUnknown,
+						Reason:  "NewObservedGenFailure",
+						Message: "unsuccessfully observed a new generation",
+					},
+				}
+				return s
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", `ProvisionedService "my-service" does not own Secret: "my-service-merged"`),
+		},
+	}, {
+		Name: "deletes merged secret without sources",
+		Key:  key,
+		Objects: []runtime.Object{
+			bindingSecret.DeepCopy(),
 			&labsv1alpha1.ProvisionedService{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
@@ -114,7 +477,15 @@ func TestReconcile(t *testing.T) {
 					Binding: binding,
 				},
 			},
+			mergedSecret.DeepCopy(),
 		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{{
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: namespace,
+				Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+			},
+			Name: "my-service-merged",
+		}},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: &labsv1alpha1.ProvisionedService{
 				ObjectMeta: metav1.ObjectMeta{
@@ -140,12 +511,18 @@ func TestReconcile(t *testing.T) {
 			},
 		}},
 		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", "my-service-merged"),
 			Eventf(corev1.EventTypeNormal, "Reconciled", "ProvisionedService reconciled: %q", key),
 		},
 	}}
 
 	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
-		r := &Reconciler{}
+		r := &Reconciler{
+			kubeclient:      kubeclient.Get(ctx),
+			secretLister:    listers.GetSecretLister(),
+			configMapLister: listers.GetConfigMapLister(),
+			tracker:         GetTracker(ctx),
+		}
 
 		return provisionedservicereconciler.NewReconciler(ctx, logging.FromContext(ctx), servicebindingsclient.Get(ctx),
 			listers.GetProvisionedServiceLister(), controller.GetEventRecorder(ctx), r)
@@ -7,12 +7,22 @@ package provisionedservice
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/tracker"
 
 	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	provisionedservicereconciler "github.com/vmware-tanzu/servicebinding/pkg/client/injection/reconciler/labs/v1alpha1/provisionedservice"
-	"knative.dev/pkg/reconciler"
+	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/provisionedservice/resources"
+	resourcenames "github.com/vmware-tanzu/servicebinding/pkg/reconciler/provisionedservice/resources/names"
 )
 
 // newReconciledNormal makes a new reconciler event with event type Normal, and
@@ -23,7 +33,12 @@ func newReconciledNormal(namespace, name string) reconciler.Event {
 
 // Reconciler implements provisionedservicereconciler.Interface for
 // ProvisionedService resources.
-type Reconciler struct{}
+type Reconciler struct {
+	kubeclient      kubernetes.Interface
+	secretLister    corev1listers.SecretLister
+	configMapLister corev1listers.ConfigMapLister
+	tracker         tracker.Interface
+}
 
 // Check that our Reconciler implements Interface
 var _ provisionedservicereconciler.Interface = (*Reconciler)(nil)
@@ -36,10 +51,182 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, o *labsv1alpha1.Provisio
 		return nil
 	}
 	o.Status.InitializeConditions()
+	o.Status.ObservedGeneration = o.Generation
+	// the binding is only published once it is valid, so that ServiceBindings
+	// do not bind a broken service
+	o.Status.Binding = corev1.LocalObjectReference{}
 
-	o.Status.Binding = o.Spec.Binding
+	if len(o.Spec.Sources) == 0 {
+		if err := r.deleteSecret(ctx, o, resourcenames.MergedSecret(o)); err != nil {
+			return err
+		}
+		secret, err := r.bindingSecret(o)
+		if err != nil {
+			return err
+		}
+		if secret == nil || !bindingValid(o, secret) {
+			return nil
+		}
+		o.Status.Binding = o.Spec.Binding
+		o.Status.MarkReady()
+		return newReconciledNormal(o.Namespace, o.Name)
+	}
 
-	o.Status.ObservedGeneration = o.Generation
+	data, err := r.sourceData(o)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		// a source is missing, the merged secret is left as is until the
+		// source is created
+		return nil
+	}
+	desired, err := resources.MakeMergedSecret(o, data)
+	if err != nil {
+		o.Status.MarkFailed("KeyNotFound", err.Error())
+		return nil
+	}
+	if !bindingValid(o, desired) {
+		return nil
+	}
+	secret, err := r.reconcileSecret(ctx, o, desired)
+	if err != nil {
+		return err
+	}
+
+	o.Status.Binding = corev1.LocalObjectReference{Name: secret.Name}
 	o.Status.MarkReady()
 	return newReconciledNormal(o.Namespace, o.Name)
 }
+
+// bindingSecret resolves the Secret referenced by the ProvisionedService,
+// tracking it for changes. A nil Secret is returned when the Secret is not
+// found, the status is updated to reflect the missing Secret.
+func (r *Reconciler) bindingSecret(service *labsv1alpha1.ProvisionedService) (*corev1.Secret, error) {
+	ref := tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  service.Namespace,
+		Name:       service.Spec.Binding.Name,
+	}
+	if err := r.tracker.TrackReference(ref, service); err != nil {
+		return nil, fmt.Errorf("failed to track Secret: %w", err)
+	}
+	secret, err := r.secretLister.Secrets(service.Namespace).Get(ref.Name)
+	if apierrs.IsNotFound(err) {
+		service.Status.MarkFailed("SecretNotFound", fmt.Sprintf("Secret %q not found", ref.Name))
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get Secret: %w", err)
+	}
+	return secret, nil
+}
+
+// bindingValid checks that the binding Secret contains the entries required
+// by the spec, updating the status when an entry is missing.
+func bindingValid(service *labsv1alpha1.ProvisionedService, secret *corev1.Secret) bool {
+	if len(secret.Data["type"]) == 0 {
+		service.Status.MarkFailed("MissingType", fmt.Sprintf("Secret %q is missing the required %q entry", secret.Name, "type"))
+		return false
+	}
+	return true
+}
+
+// sourceData reads the entries of each of the sources, tracking the sources
+// for changes. Nil data is returned when a source is not found, the status is
+// updated to reflect the missing source.
+func (r *Reconciler) sourceData(service *labsv1alpha1.ProvisionedService) ([]map[string][]byte, error) {
+	data := make([]map[string][]byte, len(service.Spec.Sources))
+	for i, source := range service.Spec.Sources {
+		ref := tracker.Reference{
+			APIVersion: "v1",
+			Namespace:  service.Namespace,
+		}
+		if source.ConfigMap != nil {
+			ref.Kind = "ConfigMap"
+			ref.Name = source.ConfigMap.Name
+		} else if source.Secret != nil {
+			ref.Kind = "Secret"
+			ref.Name = source.Secret.Name
+		}
+		if err := r.tracker.TrackReference(ref, service); err != nil {
+			return nil, fmt.Errorf("failed to track %s: %w", ref.Kind, err)
+		}
+
+		switch ref.Kind {
+		case "ConfigMap":
+			configMap, err := r.configMapLister.ConfigMaps(service.Namespace).Get(ref.Name)
+			if apierrs.IsNotFound(err) {
+				service.Status.MarkFailed("SourceNotFound", fmt.Sprintf("ConfigMap %q not found", ref.Name))
+				return nil, nil
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to get ConfigMap: %w", err)
+			}
+			data[i] = make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+			for k, v := range configMap.BinaryData {
+				data[i][k] = v
+			}
+			for k, v := range configMap.Data {
+				data[i][k] = []byte(v)
+			}
+		case "Secret":
+			secret, err := r.secretLister.Secrets(service.Namespace).Get(ref.Name)
+			if apierrs.IsNotFound(err) {
+				service.Status.MarkFailed("SourceNotFound", fmt.Sprintf("Secret %q not found", ref.Name))
+				return nil, nil
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to get Secret: %w", err)
+			}
+			data[i] = secret.Data
+		}
+	}
+	return data, nil
+}
+
+// reconcileSecret creates or updates a Secret controlled by the
+// ProvisionedService.
+func (r *Reconciler) reconcileSecret(ctx context.Context, service *labsv1alpha1.ProvisionedService, desired *corev1.Secret) (*corev1.Secret, error) {
+	recorder := controller.GetEventRecorder(ctx)
+
+	secret, err := r.secretLister.Secrets(service.Namespace).Get(desired.Name)
+	if apierrs.IsNotFound(err) {
+		secret, err = r.kubeclient.CoreV1().Secrets(service.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			recorder.Eventf(service, corev1.EventTypeWarning, "CreationFailed", "Failed to create Secret %q: %v", desired.Name, err)
+			return nil, fmt.Errorf("failed to create Secret: %w", err)
+		}
+		recorder.Eventf(service, corev1.EventTypeNormal, "Created", "Created Secret %q", desired.Name)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get Secret: %w", err)
+	} else if !metav1.IsControlledBy(secret, service) {
+		return nil, fmt.Errorf("ProvisionedService %q does not own Secret: %q", service.Name, desired.Name)
+	} else if !equality.Semantic.DeepEqual(desired.Data, secret.Data) || !equality.Semantic.DeepEqual(desired.Labels, secret.Labels) {
+		existing := secret.DeepCopy()
+		existing.Data = desired.Data
+		existing.Labels = desired.Labels
+		if secret, err = r.kubeclient.CoreV1().Secrets(service.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to update Secret: %w", err)
+		}
+	}
+	return secret, nil
+}
+
+// deleteSecret removes a previously merged binding Secret once the
+// ProvisionedService no longer has sources.
+func (r *Reconciler) deleteSecret(ctx context.Context, service *labsv1alpha1.ProvisionedService, secretName string) error {
+	recorder := controller.GetEventRecorder(ctx)
+
+	secret, err := r.secretLister.Secrets(service.Namespace).Get(secretName)
+	if apierrs.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get Secret: %w", err)
+	} else if !metav1.IsControlledBy(secret, service) {
+		return nil
+	}
+	if err := r.kubeclient.CoreV1().Secrets(service.Namespace).Delete(ctx, secretName, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Secret: %w", err)
+	}
+	recorder.Eventf(service, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", secretName)
+	return nil
+}
@@ -0,0 +1,16 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
+)
+
+func MergedSecret(service *labsv1alpha1.ProvisionedService) string {
+	return fmt.Sprintf("%s-merged", service.Name)
+}
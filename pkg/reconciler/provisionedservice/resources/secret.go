@@ -0,0 +1,72 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
+	resourcenames "github.com/vmware-tanzu/servicebinding/pkg/reconciler/provisionedservice/resources/names"
+)
+
+// MakeMergedSecret creates the binding Secret for a ProvisionedService from
+// the entries of each of its sources. The data of each source is provided in
+// the order of the sources on the spec, entries of later sources replace
+// entries of earlier sources with the same name.
+func MakeMergedSecret(service *labsv1alpha1.ProvisionedService, data []map[string][]byte) (*corev1.Secret, error) {
+	if len(data) != len(service.Spec.Sources) {
+		return nil, fmt.Errorf("expected data for %d sources, found %d", len(service.Spec.Sources), len(data))
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourcenames.MergedSecret(service),
+			Namespace: service.Namespace,
+			Labels: kmeta.UnionMaps(service.GetLabels(), map[string]string{
+				labsv1alpha1.ProvisionedServiceLabelKey: service.Name,
+			}),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(service)},
+		},
+		Data: map[string][]byte{},
+	}
+
+	for i, source := range service.Spec.Sources {
+		if len(source.Items) == 0 {
+			for k, v := range data[i] {
+				secret.Data[k] = v
+			}
+			continue
+		}
+		for _, item := range source.Items {
+			v, ok := data[i][item.Key]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found in %s", item.Key, SourceName(source))
+			}
+			name := item.Name
+			if name == "" {
+				name = item.Key
+			}
+			secret.Data[name] = v
+		}
+	}
+
+	return secret, nil
+}
+
+// SourceName describes the Secret or ConfigMap referenced by the source.
+func SourceName(source labsv1alpha1.BindingSource) string {
+	if source.ConfigMap != nil {
+		return fmt.Sprintf("ConfigMap %q", source.ConfigMap.Name)
+	}
+	if source.Secret != nil {
+		return fmt.Sprintf("Secret %q", source.Secret.Name)
+	}
+	return "unknown source"
+}
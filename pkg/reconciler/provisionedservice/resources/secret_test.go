@@ -0,0 +1,115 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMakeMergedSecret(t *testing.T) {
+	service := &labsv1alpha1.ProvisionedService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-service",
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+		},
+		Spec: labsv1alpha1.ProvisionedServiceSpec{
+			Sources: []labsv1alpha1.BindingSource{
+				{
+					Secret: &corev1.LocalObjectReference{Name: "my-credentials"},
+				},
+				{
+					ConfigMap: &corev1.LocalObjectReference{Name: "my-config"},
+					Items: []labsv1alpha1.BindingSourceItem{
+						{Key: "hostname", Name: "host"},
+						{Key: "type"},
+					},
+				},
+			},
+		},
+	}
+	objectMeta := metav1.ObjectMeta{
+		Namespace: "my-namespace",
+		Name:      "my-service-merged",
+		Labels: map[string]string{
+			"app": "my-app",
+			"bindings.labs.vmware.com/provisionedservice": "my-service",
+		},
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion:         "bindings.labs.vmware.com/v1alpha1",
+				Kind:               "ProvisionedService",
+				Name:               "my-service",
+				Controller:         ptr.Bool(true),
+				BlockOwnerDeletion: ptr.Bool(true),
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		data        []map[string][]byte
+		expected    *corev1.Secret
+		expectedErr bool
+	}{
+		{
+			name: "merges sources",
+			data: []map[string][]byte{
+				{
+					"username": []byte("user"),
+					"host":     []byte("ignored.example.com"),
+				},
+				{
+					"hostname": []byte("db.example.com"),
+					"type":     []byte("postgresql"),
+					"other":    []byte("ignored"),
+				},
+			},
+			expected: &corev1.Secret{
+				ObjectMeta: objectMeta,
+				Data: map[string][]byte{
+					"username": []byte("user"),
+					"host":     []byte("db.example.com"),
+					"type":     []byte("postgresql"),
+				},
+			},
+		},
+		{
+			name: "missing key",
+			data: []map[string][]byte{
+				{},
+				{
+					"hostname": []byte("db.example.com"),
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name:        "missing source data",
+			data:        []map[string][]byte{{}},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := MakeMergedSecret(service, c.data)
+			if (err != nil) != c.expectedErr {
+				t.Fatalf("MakeMergedSecret() expected error %v, got %v", c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("MakeMergedSecret() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
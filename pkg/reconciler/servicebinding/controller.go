@@ -8,14 +8,24 @@ package servicebinding
 import (
 	"context"
 
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
 	bindingclient "github.com/vmware-tanzu/servicebinding/pkg/client/injection/client"
+	servicebindinggrantinformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labs/v1alpha1/servicebindinggrant"
 	servicebindingprojectioninformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labsinternal/v1alpha1/servicebindingprojection"
 	servicebindinginformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/servicebinding/v1alpha3/servicebinding"
 	servicebindingreconciler "github.com/vmware-tanzu/servicebinding/pkg/client/injection/reconciler/servicebinding/v1alpha3/servicebinding"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+	"github.com/vmware-tanzu/servicebinding/pkg/metrics"
 	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
@@ -31,26 +41,89 @@ func NewController(
 
 	serviceBindingProjectionInformer := servicebindingprojectioninformer.Get(ctx)
 	serviceBindingInformer := servicebindinginformer.Get(ctx)
+	serviceBindingGrantInformer := servicebindinggrantinformer.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	configMapInformer := configmapinformer.Get(ctx)
 
 	r := &Reconciler{
+		kubeclient:                     kubeclient.Get(ctx),
 		bindingclient:                  bindingclient.Get(ctx),
+		secretLister:                   secretInformer.Lister(),
+		configMapLister:                configMapInformer.Lister(),
 		serviceBindingProjectionLister: serviceBindingProjectionInformer.Lister(),
+		serviceBindingGrantLister:      serviceBindingGrantInformer.Lister(),
 		now:                            metav1.Now,
 	}
-	impl := servicebindingreconciler.NewImpl(ctx, r)
+	impl := servicebindingreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
+		configStore := config.NewStore(logger.Named("config-store"), func(name string, _ interface{}) {
+			if name != config.BindingTypesConfigName {
+				return
+			}
+			// the binding of every ServiceBinding is validated again
+			impl.GlobalResync(serviceBindingInformer.Informer())
+		})
+		configStore.WatchConfigs(cmw)
+		return controller.Options{ConfigStore: configStore}
+	})
 	r.resolver = resolver.NewServiceableResolver(ctx, impl.EnqueueKey)
+	r.tracker = tracker.New(impl.EnqueueKey, controller.GetTrackerLease(ctx))
+	r.enqueueAfter = impl.EnqueueAfter
 
 	logger.Info("Setting up event handlers.")
 
 	serviceBindingInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	serviceBindingInformer.Informer().AddEventHandler(readyMetricsHandler(ctx, metrics.NewReadyReporter()))
 
 	handleMatchingControllers := cache.FilteringResourceEventHandler{
 		FilterFunc: controller.FilterControllerGK(servicebindingv1alpha3.Kind("ServiceBinding")),
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	}
 	serviceBindingProjectionInformer.Informer().AddEventHandler(handleMatchingControllers)
+	secretInformer.Informer().AddEventHandler(handleMatchingControllers)
 
-	r.tracker = tracker.New(impl.EnqueueKey, controller.GetTrackerLease(ctx))
+	// binding Secrets copied from other namespaces and the grants allowing
+	// the copy are tracked, as are the bindings validated against their type
+	secretInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(r.tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("Secret")),
+	))
+	configMapInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(r.tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+	))
+	serviceBindingGrantInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(r.tracker.OnChanged, labsv1alpha1.SchemeGroupVersion.WithKind("ServiceBindingGrant")),
+	))
 
 	return impl
 }
+
+// readyMetricsHandler counts ServiceBindings by their persisted Ready
+// condition.
+func readyMetricsHandler(ctx context.Context, reporter *metrics.ReadyReporter) cache.ResourceEventHandler {
+	observe := func(obj interface{}) {
+		binding, ok := obj.(*servicebindingv1alpha3.ServiceBinding)
+		if !ok {
+			return
+		}
+		status, reason := metav1.ConditionUnknown, servicebindingv1alpha3.InitializeConditionReason
+		if ready := meta.FindStatusCondition(binding.Status.Conditions, servicebindingv1alpha3.ServiceBindingConditionReady); ready != nil {
+			status, reason = ready.Status, ready.Reason
+		}
+		reporter.Observe(ctx, types.NamespacedName{Namespace: binding.Namespace, Name: binding.Name}, string(status), reason)
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: observe,
+		UpdateFunc: func(_, obj interface{}) {
+			observe(obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			binding, ok := obj.(*servicebindingv1alpha3.ServiceBinding)
+			if !ok {
+				return
+			}
+			reporter.Forget(ctx, types.NamespacedName{Namespace: binding.Namespace, Name: binding.Name})
+		},
+	}
+}
@@ -0,0 +1,20 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+)
+
+func GeneratedSecret(binding *servicebindingv1alpha3.ServiceBinding) string {
+	return fmt.Sprintf("%s-generated", binding.Name)
+}
+
+func CopiedSecret(binding *servicebindingv1alpha3.ServiceBinding) string {
+	return fmt.Sprintf("%s-copied", binding.Name)
+}
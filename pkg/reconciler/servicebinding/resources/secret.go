@@ -0,0 +1,71 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"bytes"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+	"knative.dev/pkg/kmeta"
+
+	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	resourcenames "github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebinding/resources/names"
+)
+
+// MakeGeneratedSecret creates the binding Secret for a ServiceBinding from the
+// fields of the service resource.
+func MakeGeneratedSecret(binding *servicebindingv1alpha3.ServiceBinding, service *unstructured.Unstructured) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourcenames.GeneratedSecret(binding),
+			Namespace: binding.Namespace,
+			Labels: kmeta.UnionMaps(binding.GetLabels(), map[string]string{
+				servicebindingv1alpha3.ServiceBindingLabelKey: binding.Name,
+			}),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(binding)},
+		},
+		Data: map[string][]byte{},
+	}
+
+	for _, f := range binding.Spec.Fields {
+		j := jsonpath.New(f.Key)
+		if err := j.Parse(fmt.Sprintf("{%s}", f.JSONPath)); err != nil {
+			return nil, fmt.Errorf("invalid jsonPath for key %q: %w", f.Key, err)
+		}
+		buf := &bytes.Buffer{}
+		if err := j.Execute(buf, service.UnstructuredContent()); err != nil {
+			return nil, fmt.Errorf("unable to read key %q from %s %q: %w", f.Key, service.GetKind(), service.GetName(), err)
+		}
+		secret.Data[f.Key] = buf.Bytes()
+	}
+
+	return secret, nil
+}
+
+// MakeCopiedSecret creates a copy of the binding Secret of a service in
+// another namespace, in the namespace of the ServiceBinding.
+func MakeCopiedSecret(binding *servicebindingv1alpha3.ServiceBinding, source *corev1.Secret) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourcenames.CopiedSecret(binding),
+			Namespace: binding.Namespace,
+			Labels: kmeta.UnionMaps(binding.GetLabels(), map[string]string{
+				servicebindingv1alpha3.ServiceBindingLabelKey: binding.Name,
+			}),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(binding)},
+		},
+		Type: source.Type,
+		Data: make(map[string][]byte, len(source.Data)),
+	}
+	for k, v := range source.Data {
+		secret.Data[k] = v
+	}
+	return secret
+}
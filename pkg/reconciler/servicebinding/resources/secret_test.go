@@ -0,0 +1,170 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMakeGeneratedSecret(t *testing.T) {
+	binding := &servicebindingv1alpha3.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-binding",
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+		},
+		Spec: servicebindingv1alpha3.ServiceBindingSpec{
+			Fields: []servicebindingv1alpha3.ServiceField{
+				{Key: "host", JSONPath: ".status.address.host"},
+				{Key: "port", JSONPath: ".status.address.port"},
+				{Key: "tls", JSONPath: ".spec.tls"},
+			},
+		},
+	}
+	service := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Database",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-database",
+			},
+			"spec": map[string]interface{}{
+				"tls": true,
+			},
+			"status": map[string]interface{}{
+				"address": map[string]interface{}{
+					"host": "db.example.com",
+					"port": int64(5432),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		binding     *servicebindingv1alpha3.ServiceBinding
+		service     *unstructured.Unstructured
+		expected    *corev1.Secret
+		expectedErr bool
+	}{
+		{
+			name:    "generate secret",
+			binding: binding,
+			service: service,
+			expected: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding-generated",
+					Labels: map[string]string{
+						"app":                              "my-app",
+						"servicebinding.io/servicebinding": "my-binding",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "servicebinding.io/v1alpha3",
+							Kind:               "ServiceBinding",
+							Name:               "my-binding",
+							Controller:         ptr.Bool(true),
+							BlockOwnerDeletion: ptr.Bool(true),
+						},
+					},
+				},
+				Data: map[string][]byte{
+					"host": []byte("db.example.com"),
+					"port": []byte("5432"),
+					"tls":  []byte("true"),
+				},
+			},
+		},
+		{
+			name:    "missing field",
+			binding: binding,
+			service: func() *unstructured.Unstructured {
+				s := service.DeepCopy()
+				unstructured.RemoveNestedField(s.Object, "status")
+				return s
+			}(),
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := MakeGeneratedSecret(c.binding, c.service)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("MakeGeneratedSecret() expected err %v, got %v", c.expectedErr, err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("MakeGeneratedSecret() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMakeCopiedSecret(t *testing.T) {
+	binding := &servicebindingv1alpha3.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-binding",
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "service-namespace",
+			Name:      "my-secret",
+			Labels: map[string]string{
+				"service": "my-service",
+			},
+		},
+		Type: "servicebinding.io/mysql",
+		Data: map[string][]byte{
+			"username": []byte("root"),
+		},
+	}
+	expected := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-binding-copied",
+			Labels: map[string]string{
+				"app":                              "my-app",
+				"servicebinding.io/servicebinding": "my-binding",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1alpha3",
+					Kind:               "ServiceBinding",
+					Name:               "my-binding",
+					Controller:         ptr.Bool(true),
+					BlockOwnerDeletion: ptr.Bool(true),
+				},
+			},
+		},
+		Type: "servicebinding.io/mysql",
+		Data: map[string][]byte{
+			"username": []byte("root"),
+		},
+	}
+
+	actual := MakeCopiedSecret(binding, source)
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("MakeCopiedSecret() (-expected, +actual): %s", diff)
+	}
+}
@@ -28,15 +28,32 @@ func MakeServiceBindingProjection(binding *servicebindingv1alpha3.ServiceBinding
 			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(binding)},
 		},
 		Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
-			Name:     binding.Spec.Name,
-			Type:     binding.Spec.Type,
-			Provider: binding.Spec.Provider,
-			Binding:  *binding.Status.Binding,
-			Workload: *binding.Spec.Workload,
-			Env:      binding.Spec.Env,
+			Name:        binding.Spec.Name,
+			Type:        binding.Spec.Type,
+			Provider:    binding.Spec.Provider,
+			Binding:     *binding.Status.Binding,
+			BindingKind: binding.Status.BindingKind,
+			Workload:    *binding.Spec.Workload,
+			Env:         binding.Spec.Env,
+			Mappings:    binding.Spec.Mappings,
+
+			RolloutOnSecretChange: binding.Spec.RolloutOnSecretChange,
+			MountPath:             binding.Spec.MountPath,
 		},
 	}
 
+	for i, s := range binding.Spec.Services {
+		service := labsinternalv1alpha1.ProjectedService{
+			Name:     s.Name,
+			Type:     s.Type,
+			Provider: s.Provider,
+		}
+		if i < len(binding.Status.Services) && binding.Status.Services[i].Binding != nil {
+			service.Binding = *binding.Status.Services[i].Binding
+		}
+		projection.Spec.Services = append(projection.Spec.Services, service)
+	}
+
 	for k, v := range binding.Annotations {
 		// copy forward "serice.bindings" annotations
 		if strings.Contains(k, servicebindingv1alpha3.GroupName) {
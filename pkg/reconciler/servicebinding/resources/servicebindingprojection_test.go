@@ -52,6 +52,11 @@ func TestMakeServiceBindingProjection(t *testing.T) {
 							Key:  "my-key",
 						},
 					},
+					Mappings: []servicebindingv1alpha3.SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
+					RolloutOnSecretChange: true,
+					MountPath:             "/etc/db",
 				},
 				Status: servicebindingv1alpha3.ServiceBindingStatus{
 					Binding: &corev1.LocalObjectReference{
@@ -96,6 +101,11 @@ func TestMakeServiceBindingProjection(t *testing.T) {
 							Key:  "my-key",
 						},
 					},
+					Mappings: []labsinternalv1alpha1.SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
+					RolloutOnSecretChange: true,
+					MountPath:             "/etc/db",
 					Binding: corev1.LocalObjectReference{
 						Name: "my-secret",
 					},
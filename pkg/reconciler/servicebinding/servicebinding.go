@@ -8,12 +8,18 @@ package servicebinding
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	"github.com/vmware-tanzu/servicebinding/pkg/bindingtype"
 	bindingclientset "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned"
 	servicebindingreconciler "github.com/vmware-tanzu/servicebinding/pkg/client/injection/reconciler/servicebinding/v1alpha3/servicebinding"
+	labsv1alpha1listers "github.com/vmware-tanzu/servicebinding/pkg/client/listers/labs/v1alpha1"
 	labsinternalv1alpha1listers "github.com/vmware-tanzu/servicebinding/pkg/client/listers/labsinternal/v1alpha1"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
 	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebinding/resources"
 	resourcenames "github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebinding/resources/names"
 	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
@@ -22,6 +28,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/reconciler"
@@ -37,40 +46,53 @@ func newReconciledNormal(namespace, name string) reconciler.Event {
 // Reconciler implements servicebindingreconciler.Interface for
 // ServiceBinding resources.
 type Reconciler struct {
+	kubeclient                     kubernetes.Interface
 	bindingclient                  bindingclientset.Interface
+	secretLister                   corev1listers.SecretLister
+	configMapLister                corev1listers.ConfigMapLister
 	serviceBindingProjectionLister labsinternalv1alpha1listers.ServiceBindingProjectionLister
+	serviceBindingGrantLister      labsv1alpha1listers.ServiceBindingGrantLister
 
-	resolver *resolver.ServiceableResolver
-	tracker  tracker.Interface
-	now      func() metav1.Time
+	resolver     *resolver.ServiceableResolver
+	tracker      tracker.Interface
+	enqueueAfter func(interface{}, time.Duration)
+	now          func() metav1.Time
 }
 
 // Check that our Reconciler implements Interface
 var _ servicebindingreconciler.Interface = (*Reconciler)(nil)
 
+// Check that our Reconciler implements Finalizer
+var _ servicebindingreconciler.Finalizer = (*Reconciler)(nil)
+
 // ReconcileKind implements Interface.ReconcileKind.
 func (r *Reconciler) ReconcileKind(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding) reconciler.Event {
 	logger := logging.FromContext(ctx)
 
-	if binding.GetDeletionTimestamp() != nil {
-		// Check for a DeletionTimestamp.  If present, elide the normal reconcile logic.
-		// When a controller needs finalizer handling, it would go here.
-		return nil
-	}
-
 	now := r.now()
 	binding.Status.InitializeConditions(now)
 
-	secretRef, err := r.provisionedSecret(ctx, logger, binding)
+	secretRef, err := r.provisionedSecret(ctx, logger, binding, now)
 	if err != nil {
 		return err
 	}
 	binding.Status.Binding = nil
+	binding.Status.BindingKind = ""
+	binding.Status.Services = r.services(ctx, binding)
 	if secretRef != nil {
 		binding.Status.Binding = &corev1.LocalObjectReference{
 			Name: secretRef.Name,
 		}
-		binding.Status.MarkServiceAvailable(now)
+		if secretRef.Kind == labsinternalv1alpha1.BindingKindConfigMap {
+			binding.Status.BindingKind = secretRef.Kind
+		}
+		binding.Status.MarkServicesAvailable(now)
+		if err := r.validateSecrets(ctx, binding, secretRef, now); err != nil {
+			return err
+		}
+	} else {
+		// there is no binding to check
+		binding.Status.ClearSecretValid()
 	}
 
 	serviceBindingProjection, err := r.serviceBindingProjection(ctx, logger, binding)
@@ -86,16 +108,491 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, binding *servicebindingv
 	return newReconciledNormal(binding.Namespace, binding.Name)
 }
 
-func (r *Reconciler) provisionedSecret(ctx context.Context, logger *zap.SugaredLogger, binding *servicebindingv1alpha3.ServiceBinding) (*corev1.LocalObjectReference, error) {
+// FinalizeKind implements Finalizer.FinalizeKind. Rather than waiting for
+// garbage collection, the ServiceBindingProjection is deleted and the
+// ServiceBinding is held until the projection has removed the binding from
+// each of its workloads.
+func (r *Reconciler) FinalizeKind(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding) reconciler.Event {
+	recorder := controller.GetEventRecorder(ctx)
+
+	serviceBindingProjectionName := resourcenames.ServiceBindingProjection(binding)
+	serviceBindingProjection, err := r.serviceBindingProjectionLister.ServiceBindingProjections(binding.Namespace).Get(serviceBindingProjectionName)
+	if apierrs.IsNotFound(err) {
+		// the binding is removed from every workload
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get ServiceBindingProjection: %w", err)
+	} else if !metav1.IsControlledBy(serviceBindingProjection, binding) {
+		return nil
+	}
+
+	if serviceBindingProjection.GetDeletionTimestamp() == nil {
+		if err := r.bindingclient.InternalV1alpha1().ServiceBindingProjections(binding.Namespace).Delete(ctx, serviceBindingProjectionName, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ServiceBindingProjection: %w", err)
+		}
+		recorder.Eventf(binding, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBindingProjection %q", serviceBindingProjectionName)
+	}
+	binding.Status.PropagateServiceBindingProjectionUnbinding(serviceBindingProjection, r.now())
+
+	// Hold our finalizer, the ServiceBinding is requeued as the
+	// ServiceBindingProjection changes and once it is gone.
+	return reconciler.NewEvent(corev1.EventTypeWarning, "Unbinding", "Waiting for ServiceBindingProjection %q to unbind workloads", serviceBindingProjectionName)
+}
+
+// provisionedSecret resolves the binding projected into the workload, a
+// Secret unless the service exposes its binding as a ConfigMap.
+func (r *Reconciler) provisionedSecret(ctx context.Context, logger *zap.SugaredLogger, binding *servicebindingv1alpha3.ServiceBinding, now metav1.Time) (*corev1.TypedLocalObjectReference, error) {
 	serviceRef := binding.Spec.Service.DeepCopy()
-	serviceRef.Namespace = binding.Namespace
-	return r.resolver.ServiceableFromObjectReference(ctx, serviceRef, binding)
+	if serviceRef.Namespace == "" {
+		serviceRef.Namespace = binding.Namespace
+	}
+	if serviceRef.Namespace != binding.Namespace {
+		granted, err := r.serviceBindingGranted(binding, serviceRef)
+		if err != nil {
+			return nil, err
+		}
+		if !granted {
+			binding.Status.MarkServiceUnavailable("ServiceBindingNotGranted", fmt.Sprintf("no ServiceBindingGrant in namespace %q allows namespace %q to reference %s %q", serviceRef.Namespace, binding.Namespace, serviceRef.Kind, serviceRef.Name), now)
+			// revoke access to the service's binding that was previously granted
+			if err := r.deleteSecret(ctx, binding, resourcenames.GeneratedSecret(binding)); err != nil {
+				return nil, err
+			}
+			if err := r.deleteSecret(ctx, binding, resourcenames.CopiedSecret(binding)); err != nil {
+				return nil, err
+			}
+			// unbind the workloads rather than leave them pointing at the
+			// revoked Secret
+			if err := r.deleteServiceBindingProjection(ctx, binding); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		}
+	}
+	if len(binding.Spec.Fields) != 0 {
+		if err := r.deleteSecret(ctx, binding, resourcenames.CopiedSecret(binding)); err != nil {
+			return nil, err
+		}
+		return r.generatedSecret(ctx, logger, binding, serviceRef, now)
+	}
+	if err := r.deleteSecret(ctx, binding, resourcenames.GeneratedSecret(binding)); err != nil {
+		return nil, err
+	}
+	secretRef, err := r.resolver.ServiceableFromObjectReference(ctx, serviceRef, binding)
+	if resolver.IsKindNotServed(err) {
+		r.markKindNotServed(binding, err, now)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if secretRef.Name == "" {
+		// the service publishes its binding once it is ready, we'll try again
+		// when the service changes
+		binding.Status.MarkServiceUnavailable("BindingUnavailable", fmt.Sprintf("%s %q does not expose a binding", serviceRef.Kind, serviceRef.Name), now)
+		return nil, nil
+	}
+	if secretRef.Kind == labsinternalv1alpha1.BindingKindConfigMap {
+		if serviceRef.Namespace != binding.Namespace {
+			binding.Status.MarkServiceUnavailable("UnsupportedBindingKind", fmt.Sprintf("binding ConfigMap %q in namespace %q can not be bound from another namespace", secretRef.Name, serviceRef.Namespace), now)
+			return nil, r.deleteSecret(ctx, binding, resourcenames.CopiedSecret(binding))
+		}
+		if len(binding.Spec.Mappings) != 0 {
+			// the mapped copy of the binding is a Secret
+			binding.Status.MarkServiceUnavailable("UnsupportedBindingKind", fmt.Sprintf("binding ConfigMap %q can not be mapped", secretRef.Name), now)
+			return nil, nil
+		}
+	}
+	if serviceRef.Namespace != binding.Namespace {
+		return r.copiedSecret(ctx, binding, serviceRef.Namespace, secretRef, now)
+	}
+	if err := r.deleteSecret(ctx, binding, resourcenames.CopiedSecret(binding)); err != nil {
+		return nil, err
+	}
+	return secretRef, nil
+}
+
+// markKindNotServed reports the cluster does not serve the kind of the
+// service. Installing the resource does not change the service, so the
+// ServiceBinding is requeued once the discovery information may be refreshed.
+func (r *Reconciler) markKindNotServed(binding *servicebindingv1alpha3.ServiceBinding, err error, now metav1.Time) {
+	binding.Status.MarkServiceUnavailable("KindNotServed", err.Error(), now)
+	r.enqueueAfter(binding, resolver.DefaultRefreshInterval)
+}
+
+// services resolves the binding Secret of each of the services bound
+// alongside the service. Services are resolved independently, a service that
+// is not available is reported on its status rather than failing the
+// reconcile, the ServiceBinding is requeued as the service changes.
+func (r *Reconciler) services(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding) []servicebindingv1alpha3.BoundServiceStatus {
+	if len(binding.Spec.Services) == 0 {
+		return nil
+	}
+	statuses := make([]servicebindingv1alpha3.BoundServiceStatus, len(binding.Spec.Services))
+	for i, s := range binding.Spec.Services {
+		statuses[i].Name = s.Name
+		serviceRef := s.Service.DeepCopy()
+		serviceRef.Namespace = binding.Namespace
+		secretRef, err := r.resolver.ServiceableFromObjectReference(ctx, serviceRef, binding)
+		if resolver.IsKindNotServed(err) {
+			statuses[i].Reason = "KindNotServed"
+			statuses[i].Message = err.Error()
+			r.enqueueAfter(binding, resolver.DefaultRefreshInterval)
+			continue
+		}
+		if err != nil {
+			statuses[i].Reason = "ServiceUnavailable"
+			statuses[i].Message = err.Error()
+			continue
+		}
+		if secretRef == nil || secretRef.Name == "" || secretRef.Kind != labsinternalv1alpha1.BindingKindSecret {
+			statuses[i].Reason = "BindingUnavailable"
+			statuses[i].Message = fmt.Sprintf("%s %q does not expose a binding Secret", serviceRef.Kind, serviceRef.Name)
+			continue
+		}
+		statuses[i].Available = true
+		statuses[i].Binding = &corev1.LocalObjectReference{Name: secretRef.Name}
+	}
+	return statuses
+}
+
+// servicesResolved returns true once the binding Secret of the service and
+// of each of the services bound alongside it is resolved.
+func servicesResolved(binding *servicebindingv1alpha3.ServiceBinding) bool {
+	if binding.Status.Binding == nil {
+		return false
+	}
+	for _, s := range binding.Status.Services {
+		if s.Binding == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// secretValidation is the result of checking the entries of a binding
+// against the schema registered for its type.
+type secretValidation struct {
+	valid   bool
+	reason  string
+	message string
+}
+
+// validateSecrets checks the binding of the service, and of each of the
+// services bound alongside it, as they are projected into the workload. The
+// first invalid binding is reported. The ServiceBinding is requeued as the
+// bindings change.
+func (r *Reconciler) validateSecrets(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding, secretRef *corev1.TypedLocalObjectReference, now metav1.Time) error {
+	ref := tracker.Reference{
+		APIVersion: "v1",
+		Kind:       secretRef.Kind,
+		Namespace:  binding.Namespace,
+		Name:       secretRef.Name,
+	}
+	result, err := r.validateSecret(ctx, binding, ref, func(data map[string][]byte) map[string][]byte {
+		return projectedEntries(binding, data)
+	})
+	if err != nil {
+		return err
+	}
+	results := []*secretValidation{result}
+	for i, s := range binding.Spec.Services {
+		if i >= len(binding.Status.Services) || binding.Status.Services[i].Binding == nil {
+			continue
+		}
+		s := s
+		ref := tracker.Reference{
+			APIVersion: "v1",
+			Kind:       labsinternalv1alpha1.BindingKindSecret,
+			Namespace:  binding.Namespace,
+			Name:       binding.Status.Services[i].Binding.Name,
+		}
+		result, err := r.validateSecret(ctx, binding, ref, func(data map[string][]byte) map[string][]byte {
+			return projectedServiceEntries(s, data)
+		})
+		if err != nil {
+			return err
+		}
+		if result != nil && result.message != "" {
+			result.message = fmt.Sprintf("service %q: %s", s.Name, result.message)
+		}
+		results = append(results, result)
+	}
+
+	observed := true
+	unknown := []string{}
+	for _, result := range results {
+		switch {
+		case result == nil:
+			observed = false
+		case !result.valid:
+			binding.Status.MarkSecretInvalid(result.reason, result.message, now)
+			return nil
+		case result.reason == "UnknownType":
+			unknown = append(unknown, result.message)
+		}
+	}
+	if !observed {
+		// we'll try again once each binding is observed
+		binding.Status.ClearSecretValid()
+		return nil
+	}
+	if len(unknown) != 0 {
+		binding.Status.MarkSecretValid("UnknownType", strings.Join(unknown, "; "), now)
+		return nil
+	}
+	binding.Status.MarkSecretValid("Valid", "", now)
+	return nil
+}
+
+// validateSecret checks the entries of a binding against the schema
+// registered for its type, once projected. A nil result is returned until the
+// binding is observed.
+func (r *Reconciler) validateSecret(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding, ref tracker.Reference, project func(map[string][]byte) map[string][]byte) (*secretValidation, error) {
+	if err := r.tracker.TrackReference(ref, binding); err != nil {
+		return nil, fmt.Errorf("failed to track %s: %w", ref.Kind, err)
+	}
+
+	var data map[string][]byte
+	switch ref.Kind {
+	case labsinternalv1alpha1.BindingKindConfigMap:
+		configMap, err := r.configMapLister.ConfigMaps(ref.Namespace).Get(ref.Name)
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap: %w", err)
+		}
+		data = make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+		for k, v := range configMap.BinaryData {
+			data[k] = v
+		}
+		for k, v := range configMap.Data {
+			data[k] = []byte(v)
+		}
+	default:
+		secret, err := r.secretLister.Secrets(ref.Namespace).Get(ref.Name)
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get Secret: %w", err)
+		}
+		data = secret.Data
+	}
+	data = project(data)
+
+	bindingType := string(data[bindingtype.TypeKey])
+	if bindingType == "" {
+		return &secretValidation{reason: "MissingType", message: fmt.Sprintf("%s %q does not have a %q entry", ref.Kind, ref.Name, bindingtype.TypeKey)}, nil
+	}
+	validator, ok := config.FromContextOrDefaults(ctx).BindingTypes.Lookup(bindingType)
+	if !ok {
+		return &secretValidation{valid: true, reason: "UnknownType", message: fmt.Sprintf("no schema is registered for binding type %q", bindingType)}, nil
+	}
+	if missing := validator.Missing(data); len(missing) != 0 {
+		return &secretValidation{reason: "MissingEntries", message: fmt.Sprintf("%s %q of type %q is missing required entries: %s", ref.Kind, ref.Name, bindingType, strings.Join(missing, ", "))}, nil
+	}
+	return &secretValidation{valid: true, reason: "Valid"}, nil
+}
+
+// projectedEntries approximates the entries of the binding once projected
+// into the workload, applying the type, provider and mappings of the
+// ServiceBinding. Only the presence of an entry is meaningful for derived
+// entries.
+func projectedEntries(binding *servicebindingv1alpha3.ServiceBinding, data map[string][]byte) map[string][]byte {
+	projected := make(map[string][]byte, len(data))
+	for k, v := range data {
+		projected[k] = v
+	}
+	if binding.Spec.Type != "" {
+		projected["type"] = []byte(binding.Spec.Type)
+	}
+	if binding.Spec.Provider != "" {
+		projected["provider"] = []byte(binding.Spec.Provider)
+	}
+	for _, m := range binding.Spec.Mappings {
+		switch {
+		case m.Drop:
+			delete(projected, m.Key)
+		case m.From != "":
+			if v, ok := data[m.From]; ok {
+				projected[m.Key] = v
+			}
+		default:
+			projected[m.Key] = []byte{}
+		}
+	}
+	return projected
+}
+
+// projectedServiceEntries approximates the entries of the binding of a
+// service bound alongside the service, applying its type and provider.
+func projectedServiceEntries(service servicebindingv1alpha3.BoundService, data map[string][]byte) map[string][]byte {
+	projected := make(map[string][]byte, len(data))
+	for k, v := range data {
+		projected[k] = v
+	}
+	if service.Type != "" {
+		projected["type"] = []byte(service.Type)
+	}
+	if service.Provider != "" {
+		projected["provider"] = []byte(service.Provider)
+	}
+	return projected
+}
+
+// serviceBindingGranted checks that a ServiceBindingGrant in the namespace of
+// the service allows the ServiceBinding to reference it.
+func (r *Reconciler) serviceBindingGranted(binding *servicebindingv1alpha3.ServiceBinding, serviceRef *tracker.Reference) (bool, error) {
+	grantRef := tracker.Reference{
+		APIVersion: labsv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "ServiceBindingGrant",
+		Namespace:  serviceRef.Namespace,
+		Selector:   &metav1.LabelSelector{},
+	}
+	if err := r.tracker.TrackReference(grantRef, binding); err != nil {
+		return false, fmt.Errorf("failed to track ServiceBindingGrants: %w", err)
+	}
+	grants, err := r.serviceBindingGrantLister.ServiceBindingGrants(serviceRef.Namespace).List(labels.Everything())
+	if err != nil {
+		return false, fmt.Errorf("failed to list ServiceBindingGrants: %w", err)
+	}
+	for _, grant := range grants {
+		if grant.Grants(binding.Namespace, *serviceRef) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// copiedSecret copies the binding Secret of a service in another namespace
+// into the namespace of the ServiceBinding, so that it can be projected into
+// the workload.
+func (r *Reconciler) copiedSecret(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding, namespace string, secretRef *corev1.TypedLocalObjectReference, now metav1.Time) (*corev1.TypedLocalObjectReference, error) {
+	if secretRef == nil || secretRef.Name == "" {
+		return secretRef, nil
+	}
+	sourceRef := tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  namespace,
+		Name:       secretRef.Name,
+	}
+	if err := r.tracker.TrackReference(sourceRef, binding); err != nil {
+		return nil, fmt.Errorf("failed to track Secret: %w", err)
+	}
+	source, err := r.secretLister.Secrets(namespace).Get(secretRef.Name)
+	if apierrs.IsNotFound(err) {
+		binding.Status.MarkServiceUnavailable("SecretNotFound", fmt.Sprintf("Secret %q not found in namespace %q", secretRef.Name, namespace), now)
+		return nil, r.deleteSecret(ctx, binding, resourcenames.CopiedSecret(binding))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get Secret: %w", err)
+	}
+	secret, err := r.reconcileSecret(ctx, binding, resources.MakeCopiedSecret(binding, source))
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.TypedLocalObjectReference{Kind: labsinternalv1alpha1.BindingKindSecret, Name: secret.Name}, nil
+}
+
+// generatedSecret materializes the binding Secret from the fields of the
+// service resource.
+func (r *Reconciler) generatedSecret(ctx context.Context, logger *zap.SugaredLogger, binding *servicebindingv1alpha3.ServiceBinding, serviceRef *tracker.Reference, now metav1.Time) (*corev1.TypedLocalObjectReference, error) {
+	service, err := r.resolver.ServiceFromObjectReference(ctx, serviceRef, binding)
+	if resolver.IsKindNotServed(err) {
+		r.markKindNotServed(binding, err, now)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	desired, err := resources.MakeGeneratedSecret(binding, service)
+	if err != nil {
+		// the service may not have published the field yet, we'll try again
+		// when the service changes
+		binding.Status.MarkServiceUnavailable("FieldUnavailable", err.Error(), now)
+		return nil, nil
+	}
+	secret, err := r.reconcileSecret(ctx, binding, desired)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.TypedLocalObjectReference{Kind: labsinternalv1alpha1.BindingKindSecret, Name: secret.Name}, nil
+}
+
+// reconcileSecret creates or updates a Secret controlled by the
+// ServiceBinding.
+func (r *Reconciler) reconcileSecret(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding, desired *corev1.Secret) (*corev1.Secret, error) {
+	recorder := controller.GetEventRecorder(ctx)
+
+	secret, err := r.secretLister.Secrets(binding.Namespace).Get(desired.Name)
+	if apierrs.IsNotFound(err) {
+		secret, err = r.kubeclient.CoreV1().Secrets(binding.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			recorder.Eventf(binding, corev1.EventTypeWarning, "CreationFailed", "Failed to create Secret %q: %v", desired.Name, err)
+			return nil, fmt.Errorf("failed to create Secret: %w", err)
+		}
+		recorder.Eventf(binding, corev1.EventTypeNormal, "Created", "Created Secret %q", desired.Name)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get Secret: %w", err)
+	} else if !metav1.IsControlledBy(secret, binding) {
+		return nil, fmt.Errorf("ServiceBinding %q does not own Secret: %q", binding.Name, desired.Name)
+	} else if !equality.Semantic.DeepEqual(desired.Data, secret.Data) || !equality.Semantic.DeepEqual(desired.Labels, secret.Labels) {
+		existing := secret.DeepCopy()
+		existing.Data = desired.Data
+		existing.Labels = desired.Labels
+		if secret, err = r.kubeclient.CoreV1().Secrets(binding.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to update Secret: %w", err)
+		}
+	}
+	return secret, nil
+}
+
+// deleteSecret removes a previously generated or copied binding Secret once
+// it is no longer needed by the ServiceBinding.
+func (r *Reconciler) deleteSecret(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding, secretName string) error {
+	recorder := controller.GetEventRecorder(ctx)
+
+	secret, err := r.secretLister.Secrets(binding.Namespace).Get(secretName)
+	if apierrs.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get Secret: %w", err)
+	} else if !metav1.IsControlledBy(secret, binding) {
+		return nil
+	}
+	if err := r.kubeclient.CoreV1().Secrets(binding.Namespace).Delete(ctx, secretName, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Secret: %w", err)
+	}
+	recorder.Eventf(binding, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", secretName)
+	return nil
+}
+
+// deleteServiceBindingProjection deletes the ServiceBindingProjection owned
+// by the ServiceBinding, the projection removes the binding from each of its
+// workloads before it is gone.
+func (r *Reconciler) deleteServiceBindingProjection(ctx context.Context, binding *servicebindingv1alpha3.ServiceBinding) error {
+	recorder := controller.GetEventRecorder(ctx)
+
+	serviceBindingProjectionName := resourcenames.ServiceBindingProjection(binding)
+	serviceBindingProjection, err := r.serviceBindingProjectionLister.ServiceBindingProjections(binding.Namespace).Get(serviceBindingProjectionName)
+	if apierrs.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get ServiceBindingProjection: %w", err)
+	} else if !metav1.IsControlledBy(serviceBindingProjection, binding) || serviceBindingProjection.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	if err := r.bindingclient.InternalV1alpha1().ServiceBindingProjections(binding.Namespace).Delete(ctx, serviceBindingProjectionName, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ServiceBindingProjection: %w", err)
+	}
+	recorder.Eventf(binding, corev1.EventTypeNormal, "Deleted", "Deleted ServiceBindingProjection %q", serviceBindingProjectionName)
+	return nil
 }
 
 func (r *Reconciler) serviceBindingProjection(ctx context.Context, logger *zap.SugaredLogger, binding *servicebindingv1alpha3.ServiceBinding) (*labsinternalv1alpha1.ServiceBindingProjection, error) {
 	recorder := controller.GetEventRecorder(ctx)
 
-	if binding.Status.Binding == nil {
+	if !servicesResolved(binding) {
+		// the projection is left as is until every service is available
 		return nil, nil
 	}
 
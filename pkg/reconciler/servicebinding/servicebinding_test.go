@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
@@ -16,6 +17,7 @@ import (
 	servicebindingsclient "github.com/vmware-tanzu/servicebinding/pkg/client/injection/client"
 	"github.com/vmware-tanzu/servicebinding/pkg/client/injection/ducks/duck/v1alpha3/serviceable"
 	servicebindingreconciler "github.com/vmware-tanzu/servicebinding/pkg/client/injection/reconciler/servicebinding/v1alpha3/servicebinding"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
 	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,16 +25,21 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	clientgotesting "k8s.io/client-go/testing"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
 	"knative.dev/pkg/tracker"
 
 	// register injection fakes
 	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/ducks/duck/v1alpha3/serviceable/fake"
+	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labs/v1alpha1/servicebindinggrant/fake"
 	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labsinternal/v1alpha1/servicebindingprojection/fake"
 	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/servicebinding/v1alpha3/servicebinding/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/fake"
 	_ "knative.dev/pkg/injection/clients/dynamicclient/fake"
 
 	. "github.com/vmware-tanzu/servicebinding/pkg/reconciler/testing"
@@ -42,7 +49,17 @@ import (
 func TestNewController(t *testing.T) {
 	ctx, _ := SetupFakeContext(t)
 
-	c := NewController(ctx, configmap.NewStaticWatcher())
+	c := NewController(ctx, configmap.NewStaticWatcher(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.BindingConfigName,
+			Namespace: system.Namespace(),
+		},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.BindingTypesConfigName,
+			Namespace: system.Namespace(),
+		},
+	}))
 
 	if c == nil {
 		t.Fatal("expected NewController to return a non-nil value")
@@ -70,6 +87,11 @@ func TestReconcile(t *testing.T) {
 		Kind:       provisionedService.GetGroupVersionKind().Kind,
 		Name:       provisionedService.Name,
 	}
+	unservedServiceRef := tracker.Reference{
+		APIVersion: "example.com/v1",
+		Kind:       "Database",
+		Name:       "my-database",
+	}
 	workloadRef := servicebindingv1alpha3.WorkloadReference{
 		Reference: tracker.Reference{
 			APIVersion: "apps/v1",
@@ -78,10 +100,235 @@ func TestReconcile(t *testing.T) {
 		},
 	}
 
+	services := []servicebindingv1alpha3.BoundService{
+		{
+			Name: "my-cache",
+			Type: "redis",
+			Service: &tracker.Reference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "my-cache-secret",
+			},
+		},
+	}
+
+	fields := []servicebindingv1alpha3.ServiceField{
+		{Key: "service", JSONPath: ".metadata.name"},
+	}
+	generatedSecretName := "my-binding-generated"
+	generatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      generatedSecretName,
+			Labels: map[string]string{
+				"servicebinding.io/servicebinding": "my-binding",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1alpha3",
+					Kind:               "ServiceBinding",
+					Name:               name,
+					BlockOwnerDeletion: ptr.Bool(true),
+					Controller:         ptr.Bool(true),
+				},
+			},
+		},
+		Data: map[string][]byte{
+			"service": []byte("my-service"),
+		},
+	}
+	generatedProjection := &labsinternalv1alpha1.ServiceBindingProjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				"servicebinding.io/servicebinding": "my-binding",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1alpha3",
+					Kind:               "ServiceBinding",
+					Name:               name,
+					BlockOwnerDeletion: ptr.Bool(true),
+					Controller:         ptr.Bool(true),
+				},
+			},
+		},
+		Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+			Name:     name,
+			Workload: workloadRef,
+			Binding: corev1.LocalObjectReference{
+				Name: generatedSecretName,
+			},
+		},
+	}
+
 	now := metav1.Now()
 	nowFunc := func() metav1.Time {
 		return now
 	}
+	serviceNamespace := "service-namespace"
+	copiedSecretName := "my-binding-copied"
+	crossNamespaceServiceRef := tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  serviceNamespace,
+		Name:       secretName,
+	}
+	crossNamespaceBinding := &servicebindingv1alpha3.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       name,
+			Finalizers: []string{"servicebindings.servicebinding.io"},
+			Generation: 1,
+		},
+		Spec: servicebindingv1alpha3.ServiceBindingSpec{
+			Name:     name,
+			Workload: &workloadRef,
+			Service:  &crossNamespaceServiceRef,
+		},
+	}
+	serviceBindingGrant := &labsv1alpha1.ServiceBindingGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: serviceNamespace,
+			Name:      "my-grant",
+		},
+		Spec: labsv1alpha1.ServiceBindingGrantSpec{
+			From: []labsv1alpha1.ServiceBindingGrantFrom{
+				{Namespace: namespace},
+			},
+			To: []labsv1alpha1.ServiceBindingGrantTo{
+				{Kind: "Secret", Name: secretName},
+			},
+		},
+	}
+	grantedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: serviceNamespace,
+			Name:      secretName,
+		},
+		Data: map[string][]byte{
+			"username": []byte("root"),
+		},
+	}
+	copiedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      copiedSecretName,
+			Labels: map[string]string{
+				"servicebinding.io/servicebinding": "my-binding",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1alpha3",
+					Kind:               "ServiceBinding",
+					Name:               name,
+					BlockOwnerDeletion: ptr.Bool(true),
+					Controller:         ptr.Bool(true),
+				},
+			},
+		},
+		Data: map[string][]byte{
+			"username": []byte("root"),
+		},
+	}
+	boundBinding := &servicebindingv1alpha3.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       name,
+			Generation: 1,
+			Finalizers: []string{"servicebindings.servicebinding.io"},
+		},
+		Spec: servicebindingv1alpha3.ServiceBindingSpec{
+			Name:     name,
+			Workload: &workloadRef,
+			Service:  &serviceRef,
+		},
+		Status: servicebindingv1alpha3.ServiceBindingStatus{
+			ObservedGeneration: 1,
+			Binding: &corev1.LocalObjectReference{
+				Name: secretName,
+			},
+			Conditions: []metav1.Condition{
+				{
+					Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
+					Status: metav1.ConditionTrue,
+					Reason: "Ready",
+				},
+				{
+					Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+					Status: metav1.ConditionTrue,
+					Reason: "Available",
+				},
+				{
+					Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+					Status: metav1.ConditionTrue,
+					Reason: "Projected",
+				},
+			},
+			Workloads: &servicebindingv1alpha3.WorkloadsSummary{
+				Matched:  1,
+				Injected: 1,
+			},
+		},
+	}
+	deletedBinding := boundBinding.DeepCopy()
+	deletedBinding.DeletionTimestamp = &now
+	boundProjection := &labsinternalv1alpha1.ServiceBindingProjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				"servicebinding.io/servicebinding": "my-binding",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "servicebinding.io/v1alpha3",
+					Kind:               "ServiceBinding",
+					Name:               name,
+					BlockOwnerDeletion: ptr.Bool(true),
+					Controller:         ptr.Bool(true),
+				},
+			},
+		},
+		Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+			Name:     name,
+			Workload: workloadRef,
+			Binding: corev1.LocalObjectReference{
+				Name: secretName,
+			},
+		},
+		Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{
+					{
+						Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+			Workloads: []labsinternalv1alpha1.WorkloadStatus{
+				{
+					Name:     "my-workload",
+					Injected: true,
+				},
+			},
+		},
+	}
+
+	bindingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      secretName,
+		},
+		Data: map[string][]byte{
+			"type":     []byte("postgresql"),
+			"host":     []byte("db.example.com"),
+			"database": []byte("accounts"),
+			"username": []byte("user"),
+			"password": []byte("secret"),
+		},
+	}
 
 	table := TableTest{{
 		Name: "bad workqueue key",
@@ -102,6 +349,104 @@ func TestReconcile(t *testing.T) {
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{},
 			},
 		},
+	}, {
+		Name: "adds finalizer",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Finalizers = nil
+				return b
+			}(),
+			boundProjection.DeepCopy(),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      name,
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":["servicebindings.servicebinding.io"],"resourceVersion":""}}`),
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", name),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "deletes servicebindingprojection when deleted",
+		Key:  key,
+		Objects: []runtime.Object{
+			deletedBinding.DeepCopy(),
+			boundProjection.DeepCopy(),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+					Resource:  labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"),
+				},
+				Name: name,
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: func() *servicebindingv1alpha3.ServiceBinding {
+					b := deletedBinding.DeepCopy()
+					b.Status.MarkUnbinding("Unbinding", fmt.Sprintf("waiting for ServiceBindingProjection %q to unbind workloads", name), now)
+					return b
+				}(),
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Deleted", "Deleted ServiceBindingProjection %q", name),
+			Eventf(corev1.EventTypeWarning, "Unbinding", "Waiting for ServiceBindingProjection %q to unbind workloads", name),
+		},
+	}, {
+		Name: "waits for servicebindingprojection to unbind",
+		Key:  key,
+		Objects: []runtime.Object{
+			deletedBinding.DeepCopy(),
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := boundProjection.DeepCopy()
+				p.DeletionTimestamp = &now
+				p.Status.MarkUnbinding("UnbindFailed", "1 of 1 workloads still bound: inducing failure")
+				return p
+			}(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: func() *servicebindingv1alpha3.ServiceBinding {
+					b := deletedBinding.DeepCopy()
+					b.Status.MarkUnbinding("UnbindFailed", "1 of 1 workloads still bound: inducing failure", now)
+					return b
+				}(),
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "Unbinding", "Waiting for ServiceBindingProjection %q to unbind workloads", name),
+		},
+	}, {
+		Name: "removes finalizer once unbound",
+		Key:  key,
+		Objects: []runtime.Object{
+			deletedBinding.DeepCopy(),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      name,
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":[],"resourceVersion":""}}`),
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", name),
+		},
 	}, {
 		Name: "nop - in sync",
 		Key:  key,
@@ -111,6 +456,7 @@ func TestReconcile(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
@@ -190,6 +536,7 @@ func TestReconcile(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
@@ -236,6 +583,7 @@ func TestReconcile(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
@@ -276,31 +624,268 @@ func TestReconcile(t *testing.T) {
 			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
 		},
 	}, {
-		Name: "updates servicebindingprojection",
+		Name: "validates binding secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			boundBinding.DeepCopy(),
+			boundProjection.DeepCopy(),
+			bindingSecret.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Status.MarkSecretValid("Valid", "", now)
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "binding secret missing entries",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			boundBinding.DeepCopy(),
+			boundProjection.DeepCopy(),
+			func() *corev1.Secret {
+				s := bindingSecret.DeepCopy()
+				delete(s.Data, "database")
+				delete(s.Data, "password")
+				return s
+			}(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Status.MarkSecretInvalid("MissingEntries", `Secret "my-secret" of type "postgresql" is missing required entries: database, password`, now)
+				b.Status.Conditions[0] = metav1.Condition{
+					Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "SecretValidMissingEntries",
+					Message:            `Secret "my-secret" of type "postgresql" is missing required entries: database, password`,
+					LastTransitionTime: now,
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "binding secret of unknown type",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			boundBinding.DeepCopy(),
+			boundProjection.DeepCopy(),
+			&corev1.Secret{
+				ObjectMeta: bindingSecret.ObjectMeta,
+				Data: map[string][]byte{
+					"type": []byte("oracle"),
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Status.MarkSecretValid("UnknownType", `no schema is registered for binding type "oracle"`, now)
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "binding secret validated with projected entries",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Spec.Type = "redis"
+				b.Spec.Mappings = []servicebindingv1alpha3.SecretMapping{
+					{Key: "host", From: "hostname"},
+				}
+				return b
+			}(),
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := boundProjection.DeepCopy()
+				p.Spec.Type = "redis"
+				p.Spec.Mappings = []servicebindingv1alpha3.SecretMapping{
+					{Key: "host", From: "hostname"},
+				}
+				return p
+			}(),
+			&corev1.Secret{
+				ObjectMeta: bindingSecret.ObjectMeta,
+				Data: map[string][]byte{
+					"hostname": []byte("cache.example.com"),
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Spec.Type = "redis"
+				b.Spec.Mappings = []servicebindingv1alpha3.SecretMapping{
+					{Key: "host", From: "hostname"},
+				}
+				b.Status.MarkSecretValid("Valid", "", now)
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "binding secret of services missing entries",
 		Key:  key,
 		Objects: []runtime.Object{
 			provisionedService.DeepCopy(),
+			func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Spec.Services = services
+				b.Status.Services = []servicebindingv1alpha3.BoundServiceStatus{
+					{
+						Name:      "my-cache",
+						Available: true,
+						Binding: &corev1.LocalObjectReference{
+							Name: "my-cache-secret",
+						},
+					},
+				}
+				return b
+			}(),
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := boundProjection.DeepCopy()
+				p.Spec.Services = []labsinternalv1alpha1.ProjectedService{
+					{
+						Name: "my-cache",
+						Type: "redis",
+						Binding: corev1.LocalObjectReference{
+							Name: "my-cache-secret",
+						},
+					},
+				}
+				return p
+			}(),
+			bindingSecret.DeepCopy(),
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-cache-secret",
+				},
+				Data: map[string][]byte{
+					"password": []byte("secret"),
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Spec.Services = services
+				b.Status.Services = []servicebindingv1alpha3.BoundServiceStatus{
+					{
+						Name:      "my-cache",
+						Available: true,
+						Binding: &corev1.LocalObjectReference{
+							Name: "my-cache-secret",
+						},
+					},
+				}
+				b.Status.MarkSecretInvalid("MissingEntries", `service "my-cache": Secret "my-cache-secret" of type "redis" is missing required entries: host`, now)
+				b.Status.Conditions[0] = metav1.Condition{
+					Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "SecretValidMissingEntries",
+					Message:            `service "my-cache": Secret "my-cache-secret" of type "redis" is missing required entries: host`,
+					LastTransitionTime: now,
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "clears secret valid once the binding is unavailable",
+		Key:  key,
+		Objects: []runtime.Object{
+			func() *labsv1alpha1.ProvisionedService {
+				s := provisionedService.DeepCopy()
+				s.Status.Binding = corev1.LocalObjectReference{}
+				return s
+			}(),
+			func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Status.MarkSecretValid("Valid", "", now)
+				return b
+			}(),
+			boundProjection.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := boundBinding.DeepCopy()
+				b.Status.Binding = nil
+				b.Status.Conditions = []metav1.Condition{
+					{
+						Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+						Status:             metav1.ConditionFalse,
+						Reason:             "ServiceAvailableBindingUnavailable",
+						Message:            `ProvisionedService "my-service" does not expose a binding`,
+						LastTransitionTime: now,
+					},
+					{
+						Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+						Status:             metav1.ConditionFalse,
+						Reason:             "BindingUnavailable",
+						Message:            `ProvisionedService "my-service" does not expose a binding`,
+						LastTransitionTime: now,
+					},
+					{
+						Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+						Status: metav1.ConditionTrue,
+						Reason: "Projected",
+					},
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "creates servicebindingprojection for config map",
+		Key:  key,
+		Objects: []runtime.Object{
 			&servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
 					Workload: &workloadRef,
-					Service:  &serviceRef,
-				},
-				Status: servicebindingv1alpha3.ServiceBindingStatus{
-					Binding: &corev1.LocalObjectReference{
-						Name: secretName,
+					Service: &tracker.Reference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-config-map",
 					},
 				},
 			},
+		},
+		WantCreates: []runtime.Object{
 			&labsinternalv1alpha1.ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: namespace,
 					Name:      name,
+					Labels: map[string]string{
+						"servicebinding.io/servicebinding": "my-binding",
+					},
 					OwnerReferences: []metav1.OwnerReference{
 						{
 							APIVersion:         "servicebinding.io/v1alpha3",
@@ -315,37 +900,9 @@ func TestReconcile(t *testing.T) {
 					Name:     name,
 					Workload: workloadRef,
 					Binding: corev1.LocalObjectReference{
-						Name: secretName,
-					},
-				},
-			},
-		},
-		WantUpdates: []clientgotesting.UpdateActionImpl{
-			{
-				Object: &labsinternalv1alpha1.ServiceBindingProjection{
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: namespace,
-						Name:      name,
-						Labels: map[string]string{
-							"servicebinding.io/servicebinding": "my-binding",
-						},
-						OwnerReferences: []metav1.OwnerReference{
-							{
-								APIVersion:         "servicebinding.io/v1alpha3",
-								Kind:               "ServiceBinding",
-								Name:               name,
-								BlockOwnerDeletion: ptr.Bool(true),
-								Controller:         ptr.Bool(true),
-							},
-						},
-					},
-					Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
-						Name:     name,
-						Workload: workloadRef,
-						Binding: corev1.LocalObjectReference{
-							Name: secretName,
-						},
+						Name: "my-config-map",
 					},
+					BindingKind: "ConfigMap",
 				},
 			},
 		},
@@ -354,18 +911,24 @@ func TestReconcile(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
 					Workload: &workloadRef,
-					Service:  &serviceRef,
+					Service: &tracker.Reference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-config-map",
+					},
 				},
 				Status: servicebindingv1alpha3.ServiceBindingStatus{
 					ObservedGeneration: 1,
 					Binding: &corev1.LocalObjectReference{
-						Name: secretName,
+						Name: "my-config-map",
 					},
+					BindingKind: "ConfigMap",
 					Conditions: []metav1.Condition{
 						{
 							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
@@ -390,73 +953,113 @@ func TestReconcile(t *testing.T) {
 			},
 		}},
 		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Created", "Created ServiceBindingProjection %q", name),
 			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
 		},
 	}, {
-		Name: "missing referenced service with no service projection",
+		Name: "config map can not be mapped",
 		Key:  key,
 		Objects: []runtime.Object{
 			&servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
 					Workload: &workloadRef,
-					Service:  &serviceRef,
+					Service: &tracker.Reference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-config-map",
+					},
+					Mappings: []servicebindingv1alpha3.SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
 				},
 			},
 		},
-		WantErr: true,
-		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "InternalError", "failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com %q not found", serviceRef.Name),
-		},
 		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
 			Object: &servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
 					Workload: &workloadRef,
-					Service:  &serviceRef,
+					Service: &tracker.Reference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "my-config-map",
+					},
+					Mappings: []servicebindingv1alpha3.SecretMapping{
+						{Key: "uri", From: "connection-string"},
+					},
 				},
 				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
 					Conditions: []metav1.Condition{
 						{
 							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
-							Status:             metav1.ConditionUnknown,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableUnsupportedBindingKind",
+							Message:            `binding ConfigMap "my-config-map" can not be mapped`,
 							LastTransitionTime: now,
-							Reason:             "Unknown",
 						},
 						{
 							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
-							Status:             metav1.ConditionUnknown,
+							Status:             metav1.ConditionFalse,
+							Reason:             "UnsupportedBindingKind",
+							Message:            `binding ConfigMap "my-config-map" can not be mapped`,
 							LastTransitionTime: now,
-							Reason:             "Unknown",
 						},
 						{
 							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
 							Status:             metav1.ConditionUnknown,
-							LastTransitionTime: now,
 							Reason:             "Unknown",
+							LastTransitionTime: now,
 						},
 					},
 				},
 			},
 		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
 	}, {
-		Name: "missing referenced service",
+		Name: "service binding unavailable",
 		Key:  key,
 		Objects: []runtime.Object{
+			func() *labsv1alpha1.ProvisionedService {
+				s := provisionedService.DeepCopy()
+				s.Status.Binding = corev1.LocalObjectReference{}
+				return s
+			}(),
 			&servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
@@ -466,109 +1069,115 @@ func TestReconcile(t *testing.T) {
 				},
 				Status: servicebindingv1alpha3.ServiceBindingStatus{
 					ObservedGeneration: 1,
-					Binding: &corev1.LocalObjectReference{
-						Name: secretName,
-					},
 					Conditions: []metav1.Condition{
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
-							Status: metav1.ConditionTrue,
-						},
-						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
-							Status: metav1.ConditionTrue,
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableBindingUnavailable",
+							Message:            `ProvisionedService "my-service" does not expose a binding`,
+							LastTransitionTime: now,
 						},
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
-							Status: metav1.ConditionTrue,
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "BindingUnavailable",
+							Message:            `ProvisionedService "my-service" does not expose a binding`,
+							LastTransitionTime: now,
 						},
-					},
-				},
-			},
-			&labsinternalv1alpha1.ServiceBindingProjection{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: namespace,
-					Name:      name,
-					Labels: map[string]string{
-						"servicebinding.io/servicebinding": "my-binding",
-					},
-					OwnerReferences: []metav1.OwnerReference{
 						{
-							APIVersion:         "servicebinding.io/v1alpha3",
-							Kind:               "ServiceBinding",
-							Name:               name,
-							BlockOwnerDeletion: ptr.Bool(true),
-							Controller:         ptr.Bool(true),
-						},
-					},
-				},
-				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
-					Name:     name,
-					Workload: workloadRef,
-					Binding: corev1.LocalObjectReference{
-						Name: secretName,
-					},
-				},
-				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
-					Status: duckv1.Status{
-						Conditions: duckv1.Conditions{
-							{
-								Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
-								Status: corev1.ConditionTrue,
-							},
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
 						},
 					},
 				},
 			},
-		},
-		WantErr: true,
+		}},
 		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "InternalError", "failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com %q not found", serviceRef.Name),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
 		},
 	}, {
-		Name: "error creating servicebindingprojection",
+		Name: "service kind not served",
 		Key:  key,
 		Objects: []runtime.Object{
-			provisionedService.DeepCopy(),
 			&servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
 					Workload: &workloadRef,
-					Service:  &serviceRef,
+					Service:  &unservedServiceRef,
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &unservedServiceRef,
 				},
 				Status: servicebindingv1alpha3.ServiceBindingStatus{
 					ObservedGeneration: 1,
-					Binding: &corev1.LocalObjectReference{
-						Name: secretName,
-					},
 					Conditions: []metav1.Condition{
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
-							Status: metav1.ConditionTrue,
-							Reason: "Ready",
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableKindNotServed",
+							Message:            `no resource is served for kind "Database" in "example.com/v1"`,
+							LastTransitionTime: now,
 						},
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
-							Status: metav1.ConditionTrue,
-							Reason: "Available",
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "KindNotServed",
+							Message:            `no resource is served for kind "Database" in "example.com/v1"`,
+							LastTransitionTime: now,
 						},
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
-							Status: metav1.ConditionTrue,
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
 						},
 					},
 				},
 			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
 		},
-		WithReactors: []clientgotesting.ReactionFunc{
-			InduceFailure("create", "servicebindingprojections"),
+	}, {
+		Name: "creates servicebindingprojection with services",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Services: services,
+				},
+			},
 		},
-		WantErr: true,
 		WantCreates: []runtime.Object{
 			&labsinternalv1alpha1.ServiceBindingProjection{
 				ObjectMeta: metav1.ObjectMeta{
@@ -593,89 +1202,220 @@ func TestReconcile(t *testing.T) {
 					Binding: corev1.LocalObjectReference{
 						Name: secretName,
 					},
+					Services: []labsinternalv1alpha1.ProjectedService{
+						{
+							Name: "my-cache",
+							Type: "redis",
+							Binding: corev1.LocalObjectReference{
+								Name: "my-cache-secret",
+							},
+						},
+					},
 				},
 			},
 		},
-		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "CreationFailed", "Failed to create ServiceBindingProjection %q: inducing failure for create servicebindingprojections", name),
-			Eventf(corev1.EventTypeWarning, "InternalError", "failed to create ServiceBindingProjection: inducing failure for create servicebindingprojections"),
-		},
-	}, {
-		Name: "error updating servicebindingprojection",
-		Key:  key,
-		Objects: []runtime.Object{
-			provisionedService.DeepCopy(),
-			&servicebindingv1alpha3.ServiceBinding{
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
 					Workload: &workloadRef,
 					Service:  &serviceRef,
+					Services: services,
 				},
 				Status: servicebindingv1alpha3.ServiceBindingStatus{
 					ObservedGeneration: 1,
 					Binding: &corev1.LocalObjectReference{
 						Name: secretName,
 					},
+					Services: []servicebindingv1alpha3.BoundServiceStatus{
+						{
+							Name:      "my-cache",
+							Available: true,
+							Binding: &corev1.LocalObjectReference{
+								Name: "my-cache-secret",
+							},
+						},
+					},
 					Conditions: []metav1.Condition{
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
-							Status: metav1.ConditionTrue,
-							Reason: "Ready",
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "ProjectionReadyUnknown",
+							LastTransitionTime: now,
 						},
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
-							Status: metav1.ConditionTrue,
-							Reason: "Available",
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Available",
+							LastTransitionTime: now,
 						},
 						{
-							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
-							Status: metav1.ConditionTrue,
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
 						},
 					},
 				},
 			},
-			&labsinternalv1alpha1.ServiceBindingProjection{
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Created", "Created ServiceBindingProjection %q", name),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "services unavailable",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
-					Namespace: namespace,
-					Name:      name,
-					OwnerReferences: []metav1.OwnerReference{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Services: []servicebindingv1alpha3.BoundService{
+						services[0],
 						{
-							APIVersion:         "servicebinding.io/v1alpha3",
-							Kind:               "ServiceBinding",
-							Name:               name,
-							BlockOwnerDeletion: ptr.Bool(true),
-							Controller:         ptr.Bool(true),
+							Name: "my-queue",
+							Service: &tracker.Reference{
+								APIVersion: provisionedService.GetGroupVersionKind().GroupVersion().String(),
+								Kind:       provisionedService.GetGroupVersionKind().Kind,
+								Name:       "my-queue",
+							},
 						},
 					},
 				},
-				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
 					Name:     name,
-					Workload: workloadRef,
-					Binding: corev1.LocalObjectReference{
-						Name: secretName,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Services: []servicebindingv1alpha3.BoundService{
+						services[0],
+						{
+							Name: "my-queue",
+							Service: &tracker.Reference{
+								APIVersion: provisionedService.GetGroupVersionKind().GroupVersion().String(),
+								Kind:       provisionedService.GetGroupVersionKind().Kind,
+								Name:       "my-queue",
+							},
+						},
 					},
 				},
-				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
-					Status: duckv1.Status{
-						Conditions: duckv1.Conditions{
-							{
-								Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
-								Status: corev1.ConditionTrue,
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Services: []servicebindingv1alpha3.BoundServiceStatus{
+						{
+							Name:      "my-cache",
+							Available: true,
+							Binding: &corev1.LocalObjectReference{
+								Name: "my-cache-secret",
 							},
 						},
+						{
+							Name:    "my-queue",
+							Reason:  "ServiceUnavailable",
+							Message: `failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com "my-queue" not found`,
+						},
 					},
-				},
-			},
-		},
-		WithReactors: []clientgotesting.ReactionFunc{
-			InduceFailure("update", "servicebindingprojections"),
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableServiceUnavailable",
+							Message:            `service "my-queue": failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com "my-queue" not found`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceUnavailable",
+							Message:            `service "my-queue": failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com "my-queue" not found`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+					},
+				},
+			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "updates servicebindingprojection",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+				},
+			},
+			&labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "servicebinding.io/v1alpha3",
+							Kind:               "ServiceBinding",
+							Name:               name,
+							BlockOwnerDeletion: ptr.Bool(true),
+							Controller:         ptr.Bool(true),
+						},
+					},
+				},
+				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+					Name:     name,
+					Workload: workloadRef,
+					Binding: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+				},
+			},
 		},
-		WantErr: true,
 		WantUpdates: []clientgotesting.UpdateActionImpl{
 			{
 				Object: &labsinternalv1alpha1.ServiceBindingProjection{
@@ -702,31 +1442,121 @@ func TestReconcile(t *testing.T) {
 							Name: secretName,
 						},
 					},
-					Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
-						Status: duckv1.Status{
-							Conditions: duckv1.Conditions{
-								{
-									Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
-									Status: corev1.ConditionTrue,
-								},
-							},
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "ProjectionReadyUnknown",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Available",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
 						},
 					},
 				},
 			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "missing referenced service with no service projection",
+		Key:  key,
+		Objects: []runtime.Object{
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+			},
 		},
+		WantErr: true,
 		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "InternalError", "failed to reconcile ServiceBindingProjection: inducing failure for update servicebindingprojections"),
+			Eventf(corev1.EventTypeWarning, "InternalError", "failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com %q not found", serviceRef.Name),
 		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionUnknown,
+							LastTransitionTime: now,
+							Reason:             "Unknown",
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionUnknown,
+							LastTransitionTime: now,
+							Reason:             "Unknown",
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							LastTransitionTime: now,
+							Reason:             "Unknown",
+						},
+					},
+				},
+			},
+		}},
 	}, {
-		Name: "error servicebindingprojection is not owned by us",
+		Name: "missing referenced service",
 		Key:  key,
 		Objects: []runtime.Object{
-			provisionedService.DeepCopy(),
 			&servicebindingv1alpha3.ServiceBinding{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:  namespace,
 					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
 					Generation: 1,
 				},
 				Spec: servicebindingv1alpha3.ServiceBindingSpec{
@@ -743,12 +1573,10 @@ func TestReconcile(t *testing.T) {
 						{
 							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
 							Status: metav1.ConditionTrue,
-							Reason: "Ready",
 						},
 						{
 							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
 							Status: metav1.ConditionTrue,
-							Reason: "Available",
 						},
 						{
 							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
@@ -761,6 +1589,18 @@ func TestReconcile(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: namespace,
 					Name:      name,
+					Labels: map[string]string{
+						"servicebinding.io/servicebinding": "my-binding",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "servicebinding.io/v1alpha3",
+							Kind:               "ServiceBinding",
+							Name:               name,
+							BlockOwnerDeletion: ptr.Bool(true),
+							Controller:         ptr.Bool(true),
+						},
+					},
 				},
 				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
 					Name:     name,
@@ -783,7 +1623,820 @@ func TestReconcile(t *testing.T) {
 		},
 		WantErr: true,
 		WantEvents: []string{
-			Eventf(corev1.EventTypeWarning, "InternalError", "ServiceBinding %q does not own ServiceBindingProjection: %q", name, name),
+			Eventf(corev1.EventTypeWarning, "InternalError", "failed to get resource for bindings.labs.vmware.com/v1alpha1, Resource=provisionedservices: provisionedservices.bindings.labs.vmware.com %q not found", serviceRef.Name),
+		},
+	}, {
+		Name: "error creating servicebindingprojection",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status: metav1.ConditionTrue,
+							Reason: "Ready",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status: metav1.ConditionTrue,
+							Reason: "Available",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status: metav1.ConditionTrue,
+						},
+					},
+				},
+			},
+		},
+		WithReactors: []clientgotesting.ReactionFunc{
+			InduceFailure("create", "servicebindingprojections"),
+		},
+		WantErr: true,
+		WantCreates: []runtime.Object{
+			&labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+					Labels: map[string]string{
+						"servicebinding.io/servicebinding": "my-binding",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "servicebinding.io/v1alpha3",
+							Kind:               "ServiceBinding",
+							Name:               name,
+							BlockOwnerDeletion: ptr.Bool(true),
+							Controller:         ptr.Bool(true),
+						},
+					},
+				},
+				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+					Name:     name,
+					Workload: workloadRef,
+					Binding: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+				},
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "CreationFailed", "Failed to create ServiceBindingProjection %q: inducing failure for create servicebindingprojections", name),
+			Eventf(corev1.EventTypeWarning, "InternalError", "failed to create ServiceBindingProjection: inducing failure for create servicebindingprojections"),
+		},
+	}, {
+		Name: "error updating servicebindingprojection",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status: metav1.ConditionTrue,
+							Reason: "Ready",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status: metav1.ConditionTrue,
+							Reason: "Available",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status: metav1.ConditionTrue,
+						},
+					},
+				},
+			},
+			&labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "servicebinding.io/v1alpha3",
+							Kind:               "ServiceBinding",
+							Name:               name,
+							BlockOwnerDeletion: ptr.Bool(true),
+							Controller:         ptr.Bool(true),
+						},
+					},
+				},
+				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+					Name:     name,
+					Workload: workloadRef,
+					Binding: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+				},
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+		},
+		WithReactors: []clientgotesting.ReactionFunc{
+			InduceFailure("update", "servicebindingprojections"),
+		},
+		WantErr: true,
+		WantUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: &labsinternalv1alpha1.ServiceBindingProjection{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      name,
+						Labels: map[string]string{
+							"servicebinding.io/servicebinding": "my-binding",
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion:         "servicebinding.io/v1alpha3",
+								Kind:               "ServiceBinding",
+								Name:               name,
+								BlockOwnerDeletion: ptr.Bool(true),
+								Controller:         ptr.Bool(true),
+							},
+						},
+					},
+					Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+						Name:     name,
+						Workload: workloadRef,
+						Binding: corev1.LocalObjectReference{
+							Name: secretName,
+						},
+					},
+					Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+						Status: duckv1.Status{
+							Conditions: duckv1.Conditions{
+								{
+									Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", "failed to reconcile ServiceBindingProjection: inducing failure for update servicebindingprojections"),
+		},
+	}, {
+		Name: "error servicebindingprojection is not owned by us",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status: metav1.ConditionTrue,
+							Reason: "Ready",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status: metav1.ConditionTrue,
+							Reason: "Available",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status: metav1.ConditionTrue,
+						},
+					},
+				},
+			},
+			&labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+					Name:     name,
+					Workload: workloadRef,
+					Binding: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+				},
+				Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+		},
+		WantErr: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeWarning, "InternalError", "ServiceBinding %q does not own ServiceBindingProjection: %q", name, name),
+		},
+	}, {
+		Name: "creates generated secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Fields:   fields,
+				},
+			},
+		},
+		WantCreates: []runtime.Object{
+			// actions are recorded per client, the binding client before the
+			// kube client
+			generatedProjection.DeepCopy(),
+			generatedSecret.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Fields:   fields,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: generatedSecretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "SecretValidMissingType",
+							Message:            `Secret "my-binding-generated" does not have a "type" entry`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Available",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionSecretValid,
+							Status:             metav1.ConditionFalse,
+							Reason:             "MissingType",
+							Message:            `Secret "my-binding-generated" does not have a "type" entry`,
+							LastTransitionTime: now,
+						},
+					},
+				},
+			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Created", "Created Secret %q", generatedSecretName),
+			Eventf(corev1.EventTypeNormal, "Created", "Created ServiceBindingProjection %q", name),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "updates generated secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Fields:   fields,
+				},
+			},
+			func() *corev1.Secret {
+				s := generatedSecret.DeepCopy()
+				s.Data["service"] = []byte("my-old-service")
+				return s
+			}(),
+			generatedProjection.DeepCopy(),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: generatedSecret.DeepCopy(),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Fields:   fields,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: generatedSecretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "SecretValidMissingType",
+							Message:            `Secret "my-binding-generated" does not have a "type" entry`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Available",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionSecretValid,
+							Status:             metav1.ConditionFalse,
+							Reason:             "MissingType",
+							Message:            `Secret "my-binding-generated" does not have a "type" entry`,
+							LastTransitionTime: now,
+						},
+					},
+				},
+			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "generated secret field unavailable",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Fields: []servicebindingv1alpha3.ServiceField{
+						{Key: "host", JSONPath: ".status.host"},
+					},
+				},
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+					Fields: []servicebindingv1alpha3.ServiceField{
+						{Key: "host", JSONPath: ".status.host"},
+					},
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableFieldUnavailable",
+							Message:            `unable to read key "host" from ProvisionedService "my-service": host is not found`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "FieldUnavailable",
+							Message:            `unable to read key "host" from ProvisionedService "my-service": host is not found`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             servicebindingv1alpha3.InitializeConditionReason,
+							LastTransitionTime: now,
+						},
+					},
+				},
+			},
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "deletes generated secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			provisionedService.DeepCopy(),
+			&servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  namespace,
+					Name:       name,
+					Finalizers: []string{"servicebindings.servicebinding.io"},
+					Generation: 1,
+				},
+				Spec: servicebindingv1alpha3.ServiceBindingSpec{
+					Name:     name,
+					Workload: &workloadRef,
+					Service:  &serviceRef,
+				},
+				Status: servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status: metav1.ConditionUnknown,
+							Reason: "ProjectionReadyUnknown",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status: metav1.ConditionTrue,
+							Reason: "Available",
+						},
+						{
+							Type:   servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status: metav1.ConditionUnknown,
+							Reason: "Unknown",
+						},
+					},
+				},
+			},
+			generatedSecret.DeepCopy(),
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := generatedProjection.DeepCopy()
+				p.Spec.Binding.Name = secretName
+				return p
+			}(),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+					Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+				},
+				Name: generatedSecretName,
+			},
+		},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", generatedSecretName),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "copies secret granted from another namespace",
+		Key:  key,
+		Objects: []runtime.Object{
+			crossNamespaceBinding.DeepCopy(),
+			serviceBindingGrant.DeepCopy(),
+			grantedSecret.DeepCopy(),
+		},
+		WantCreates: []runtime.Object{
+			&labsinternalv1alpha1.ServiceBindingProjection{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+					Labels: map[string]string{
+						"servicebinding.io/servicebinding": "my-binding",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion:         "servicebinding.io/v1alpha3",
+							Kind:               "ServiceBinding",
+							Name:               name,
+							BlockOwnerDeletion: ptr.Bool(true),
+							Controller:         ptr.Bool(true),
+						},
+					},
+				},
+				Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+					Name:     name,
+					Workload: workloadRef,
+					Binding: corev1.LocalObjectReference{
+						Name: copiedSecretName,
+					},
+				},
+			},
+			copiedSecret.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := crossNamespaceBinding.DeepCopy()
+				b.Status = servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Binding: &corev1.LocalObjectReference{
+						Name: copiedSecretName,
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "SecretValidMissingType",
+							Message:            `Secret "my-binding-copied" does not have a "type" entry`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionTrue,
+							Reason:             "Available",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionSecretValid,
+							Status:             metav1.ConditionFalse,
+							Reason:             "MissingType",
+							Message:            `Secret "my-binding-copied" does not have a "type" entry`,
+							LastTransitionTime: now,
+						},
+					},
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Created", "Created Secret %q", copiedSecretName),
+			Eventf(corev1.EventTypeNormal, "Created", "Created ServiceBindingProjection %q", name),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "service in another namespace not granted",
+		Key:  key,
+		Objects: []runtime.Object{
+			crossNamespaceBinding.DeepCopy(),
+			func() *labsv1alpha1.ServiceBindingGrant {
+				g := serviceBindingGrant.DeepCopy()
+				g.Spec.From[0].Namespace = "other-namespace"
+				return g
+			}(),
+			grantedSecret.DeepCopy(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := crossNamespaceBinding.DeepCopy()
+				b.Status = servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableServiceBindingNotGranted",
+							Message:            `no ServiceBindingGrant in namespace "service-namespace" allows namespace "my-namespace" to reference Secret "my-secret"`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceBindingNotGranted",
+							Message:            `no ServiceBindingGrant in namespace "service-namespace" allows namespace "my-namespace" to reference Secret "my-secret"`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+					},
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "revokes copied secret when grant is removed",
+		Key:  key,
+		Objects: []runtime.Object{
+			func() *servicebindingv1alpha3.ServiceBinding {
+				b := crossNamespaceBinding.DeepCopy()
+				b.Status.Binding = &corev1.LocalObjectReference{
+					Name: copiedSecretName,
+				}
+				return b
+			}(),
+			grantedSecret.DeepCopy(),
+			copiedSecret.DeepCopy(),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+					Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+				},
+				Name: copiedSecretName,
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := crossNamespaceBinding.DeepCopy()
+				b.Status = servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableServiceBindingNotGranted",
+							Message:            `no ServiceBindingGrant in namespace "service-namespace" allows namespace "my-namespace" to reference Secret "my-secret"`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceBindingNotGranted",
+							Message:            `no ServiceBindingGrant in namespace "service-namespace" allows namespace "my-namespace" to reference Secret "my-secret"`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+					},
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", copiedSecretName),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
+		},
+	}, {
+		Name: "unbinds workloads when grant is removed",
+		Key:  key,
+		Objects: []runtime.Object{
+			func() *servicebindingv1alpha3.ServiceBinding {
+				b := crossNamespaceBinding.DeepCopy()
+				b.Status.Binding = &corev1.LocalObjectReference{
+					Name: copiedSecretName,
+				}
+				return b
+			}(),
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := boundProjection.DeepCopy()
+				p.Spec.Binding.Name = copiedSecretName
+				return p
+			}(),
+			grantedSecret.DeepCopy(),
+			copiedSecret.DeepCopy(),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+					Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+				},
+				Name: copiedSecretName,
+			},
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+					Resource:  labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"),
+				},
+				Name: name,
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: func() *servicebindingv1alpha3.ServiceBinding {
+				b := crossNamespaceBinding.DeepCopy()
+				b.Status = servicebindingv1alpha3.ServiceBindingStatus{
+					ObservedGeneration: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceAvailableServiceBindingNotGranted",
+							Message:            `no ServiceBindingGrant in namespace "service-namespace" allows namespace "my-namespace" to reference Secret "my-secret"`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionServiceAvailable,
+							Status:             metav1.ConditionFalse,
+							Reason:             "ServiceBindingNotGranted",
+							Message:            `no ServiceBindingGrant in namespace "service-namespace" allows namespace "my-namespace" to reference Secret "my-secret"`,
+							LastTransitionTime: now,
+						},
+						{
+							Type:               servicebindingv1alpha3.ServiceBindingConditionProjectionReady,
+							Status:             metav1.ConditionUnknown,
+							Reason:             "Unknown",
+							LastTransitionTime: now,
+						},
+					},
+				}
+				return b
+			}(),
+		}},
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", copiedSecretName),
+			Eventf(corev1.EventTypeNormal, "Deleted", "Deleted ServiceBindingProjection %q", name),
+			Eventf(corev1.EventTypeNormal, "Reconciled", "ServiceBinding reconciled: %q", key),
 		},
 	}}
 
@@ -791,10 +2444,15 @@ func TestReconcile(t *testing.T) {
 		ctx = serviceable.WithDuck(ctx)
 
 		r := &Reconciler{
+			kubeclient:                     kubeclient.Get(ctx),
 			bindingclient:                  servicebindingsclient.Get(ctx),
+			secretLister:                   listers.GetSecretLister(),
+			configMapLister:                listers.GetConfigMapLister(),
 			resolver:                       resolver.NewServiceableResolver(ctx, func(types.NamespacedName) {}),
 			serviceBindingProjectionLister: listers.GetServiceBindingProjectionLister(),
+			serviceBindingGrantLister:      listers.GetServiceBindingGrantLister(),
 			tracker:                        GetTracker(ctx),
+			enqueueAfter:                   func(interface{}, time.Duration) {},
 			now:                            nowFunc,
 		}
 
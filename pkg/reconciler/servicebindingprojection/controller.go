@@ -10,14 +10,22 @@ import (
 
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	servicebindingprojectioninformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labsinternal/v1alpha1/servicebindingprojection"
+	clusterworkloadresourcemappinginformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/servicebinding/v1beta1/clusterworkloadresourcemapping"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+	"github.com/vmware-tanzu/servicebinding/pkg/metrics"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/apis/duck"
-	"knative.dev/pkg/client/injection/ducks/duck/v1/podspecable"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
 	nsinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/clients/dynamicclient"
@@ -38,18 +46,28 @@ func NewController(
 	logger := logging.FromContext(ctx)
 	serviceBindingProjectionInformer := servicebindingprojectioninformer.Get(ctx)
 	nsInformer := nsinformer.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	configMapInformer := configmapinformer.Get(ctx)
+	clusterWorkloadResourceMappingInformer := clusterworkloadresourcemappinginformer.Get(ctx)
 	dc := dynamicclient.Get(ctx)
 
-	psInformerFactory := podspecable.Get(ctx)
-	c := &psbinding.BaseReconciler{
-		GVR: labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"),
-		Get: func(namespace string, name string) (psbinding.Bindable, error) {
-			return serviceBindingProjectionInformer.Lister().ServiceBindingProjections(namespace).Get(name)
+	c := &Reconciler{
+		BaseReconciler: &psbinding.BaseReconciler{
+			GVR: labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"),
+			Get: func(namespace string, name string) (psbinding.Bindable, error) {
+				return serviceBindingProjectionInformer.Lister().ServiceBindingProjections(namespace).Get(name)
+			},
+			DynamicClient: dc,
+			Recorder: record.NewBroadcaster().NewRecorder(
+				scheme.Scheme, corev1.EventSource{Component: controllerAgentName}),
+			NamespaceLister: nsInformer.Lister(),
 		},
-		DynamicClient: dc,
-		Recorder: record.NewBroadcaster().NewRecorder(
-			scheme.Scheme, corev1.EventSource{Component: controllerAgentName}),
-		NamespaceLister: nsInformer.Lister(),
+		resourceMapper:    resolver.NewResourceMapper(kubeclient.Get(ctx).Discovery()),
+		mappingResolver:   resolver.NewWorkloadMappingResolver(clusterWorkloadResourceMappingInformer.Lister()),
+		kubeclient:        kubeclient.Get(ctx),
+		secretLister:      secretInformer.Lister(),
+		configMapLister:   configMapInformer.Lister(),
+		workloadsReporter: metrics.NewWorkloadsReporter(),
 	}
 
 	impl := controller.NewImpl(c, logger, "ServiceBindingProjections")
@@ -57,17 +75,114 @@ func NewController(
 	logger.Info("Setting up event handlers")
 
 	serviceBindingProjectionInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+	serviceBindingProjectionInformer.Informer().AddEventHandler(workloadsMetricsHandler(ctx, c.workloadsReporter))
+	// A new or updated mapping may change how any workload is bound
+	clusterWorkloadResourceMappingInformer.Informer().AddEventHandler(controller.HandleAll(func(interface{}) {
+		impl.GlobalResync(serviceBindingProjectionInformer.Informer())
+	}))
+
+	c.WithContext = NewBindableContext(ctx, cmw, func(name string, _ interface{}) {
+		if name != config.BindingConfigName {
+			return
+		}
+		// a new mount root changes how every workload is bound
+		impl.GlobalResync(serviceBindingProjectionInformer.Informer())
+	})
+	// The mount root annotation of a namespace applies to the workloads
+	// bound in the namespace
+	nsInformer.Informer().AddEventHandler(mountRootChangedHandler(func(namespace string) {
+		impl.FilteredGlobalResync(func(obj interface{}) bool {
+			projection, ok := obj.(*labsinternalv1alpha1.ServiceBindingProjection)
+			return ok && projection.Namespace == namespace
+		}, serviceBindingProjectionInformer.Informer())
+	}))
 
 	c.Tracker = tracker.New(impl.EnqueueKey, controller.GetTrackerLease(ctx))
-	c.Factory = &duck.CachedInformerFactory{
+	// Changes to a binding secret are mapped again, the mapped secret is
+	// restored when changed
+	secretInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(c.Tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("Secret")),
+	))
+	// Changes to a ConfigMap binding roll out the workloads
+	configMapInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(c.Tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+	))
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGK(labsinternalv1alpha1.Kind("ServiceBindingProjection")),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+	c.unstructuredFactory = &duck.CachedInformerFactory{
 		Delegate: &duck.EnqueueInformerFactory{
-			Delegate:     psInformerFactory,
+			Delegate: &resolver.UnstructuredInformerFactory{
+				Client:       dc,
+				ResyncPeriod: controller.GetResyncPeriod(ctx),
+				StopChannel:  ctx.Done(),
+			},
 			EventHandler: controller.HandleAll(c.Tracker.OnChanged),
 		},
 	}
 	return impl
 }
 
+// mountRootChangedHandler calls fn with the name of a namespace when its
+// mount root annotation changes. Other updates to the namespace, and resyncs,
+// don't change how workloads are bound.
+func mountRootChangedHandler(fn func(namespace string)) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNs, ok := oldObj.(*corev1.Namespace)
+			if !ok {
+				return
+			}
+			newNs, ok := newObj.(*corev1.Namespace)
+			if !ok {
+				return
+			}
+			if oldNs.Annotations[config.MountRootAnnotationKey] == newNs.Annotations[config.MountRootAnnotationKey] {
+				return
+			}
+			fn(newNs.Name)
+		},
+	}
+}
+
+// workloadsMetricsHandler stops counting the workloads bound by a
+// ServiceBindingProjection once it is deleted, they are counted as the
+// projection is reconciled.
+func workloadsMetricsHandler(ctx context.Context, reporter *metrics.WorkloadsReporter) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			projection, ok := obj.(*labsinternalv1alpha1.ServiceBindingProjection)
+			if !ok {
+				return
+			}
+			reporter.Forget(ctx, types.NamespacedName{Namespace: projection.Namespace, Name: projection.Name})
+		},
+	}
+}
+
+// NewBindableContext infuses the context passed to Do and Undo with the
+// mount root for workloads in the namespace of the Bindable. The mount root
+// is read from the binding ConfigMap, unless overridden by the namespace.
+func NewBindableContext(ctx context.Context, cmw configmap.Watcher, onAfterStore ...func(name string, value interface{})) psbinding.BindableContext {
+	store := config.NewStore(logging.FromContext(ctx).Named("config-store"), onAfterStore...)
+	store.WatchConfigs(cmw)
+	nsLister := nsinformer.Get(ctx).Lister()
+
+	return func(ctx context.Context, fb psbinding.Bindable) (context.Context, error) {
+		cfg := store.Load()
+		ns, err := nsLister.Get(fb.GetNamespace())
+		if err != nil && !apierrs.IsNotFound(err) {
+			return nil, err
+		}
+		ctx = config.ToContext(ctx, cfg)
+		return labsinternalv1alpha1.WithMountRoot(ctx, cfg.Binding.MountRootForNamespace(ns)), nil
+	}
+}
+
 func ListAll(ctx context.Context, handler cache.ResourceEventHandler) psbinding.ListAll {
 	serviceBindingProjectionInformer := servicebindingprojectioninformer.Get(ctx)
 
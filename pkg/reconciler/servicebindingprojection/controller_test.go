@@ -0,0 +1,78 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package servicebindingprojection
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+)
+
+func TestMountRootChangedHandler(t *testing.T) {
+	namespace := func(annotations, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-namespace",
+				Annotations: annotations,
+				Labels:      labels,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		oldObj   interface{}
+		newObj   interface{}
+		expected []string
+	}{
+		{
+			name:     "resync",
+			oldObj:   namespace(map[string]string{config.MountRootAnnotationKey: "/custom"}, nil),
+			newObj:   namespace(map[string]string{config.MountRootAnnotationKey: "/custom"}, nil),
+			expected: []string{},
+		},
+		{
+			name:     "unrelated labels",
+			oldObj:   namespace(nil, nil),
+			newObj:   namespace(nil, map[string]string{"team": "my-team"}),
+			expected: []string{},
+		},
+		{
+			name:     "mount root added",
+			oldObj:   namespace(nil, nil),
+			newObj:   namespace(map[string]string{config.MountRootAnnotationKey: "/custom"}, nil),
+			expected: []string{"my-namespace"},
+		},
+		{
+			name:     "mount root changed",
+			oldObj:   namespace(map[string]string{config.MountRootAnnotationKey: "/custom"}, nil),
+			newObj:   namespace(map[string]string{config.MountRootAnnotationKey: "/other"}, nil),
+			expected: []string{"my-namespace"},
+		},
+		{
+			name:     "mount root removed",
+			oldObj:   namespace(map[string]string{config.MountRootAnnotationKey: "/custom"}, nil),
+			newObj:   namespace(nil, nil),
+			expected: []string{"my-namespace"},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := []string{}
+			handler := mountRootChangedHandler(func(namespace string) {
+				actual = append(actual, namespace)
+			})
+			handler.OnUpdate(c.oldObj, c.newObj)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: mountRootChangedHandler() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
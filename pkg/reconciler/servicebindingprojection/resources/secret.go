@@ -0,0 +1,97 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+)
+
+// MakeMappedSecret creates the Secret projected into the workload for a
+// ServiceBindingProjection with mappings. Entries of the binding secret are
+// renamed, dropped or derived according to the mappings, templates are
+// evaluated over the original entries of the binding secret.
+func MakeMappedSecret(projection *labsinternalv1alpha1.ServiceBindingProjection, binding *corev1.Secret) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            projection.MappedSecretName(),
+			Namespace:       projection.Namespace,
+			Labels:          kmeta.CopyMap(projection.GetLabels()),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(projection)},
+		},
+		Data: map[string][]byte{},
+	}
+
+	values := map[string]string{}
+	for k, v := range binding.Data {
+		secret.Data[k] = v
+		values[k] = string(v)
+	}
+
+	// remove renamed and dropped entries before setting new entries, so that
+	// keys may be swapped
+	for _, m := range projection.Spec.Mappings {
+		if m.From != "" {
+			delete(secret.Data, m.From)
+		}
+		if m.Drop {
+			delete(secret.Data, m.Key)
+		}
+	}
+	for _, m := range projection.Spec.Mappings {
+		switch {
+		case m.From != "":
+			v, ok := binding.Data[m.From]
+			if !ok {
+				return nil, fmt.Errorf("unable to map key %q, Secret %q has no key %q", m.Key, binding.Name, m.From)
+			}
+			secret.Data[m.Key] = v
+		case m.Template != "":
+			t, err := m.ParseTemplate()
+			if err != nil {
+				return nil, fmt.Errorf("invalid template for key %q: %w", m.Key, err)
+			}
+			buf := &bytes.Buffer{}
+			if err := t.Execute(buf, values); err != nil {
+				return nil, fmt.Errorf("unable to map key %q from Secret %q: %w", m.Key, binding.Name, err)
+			}
+			secret.Data[m.Key] = buf.Bytes()
+		}
+	}
+
+	return secret, nil
+}
+
+// SecretDataHash is a stable hash of the data of the Secrets. Secrets with the
+// same entries have the same hash.
+func SecretDataHash(secrets ...*corev1.Secret) string {
+	h := sha256.New()
+	for i, secret := range secrets {
+		if i != 0 {
+			// entries start with a length, separating the secrets
+			h.Write([]byte("|"))
+		}
+		keys := make([]string, 0, len(secret.Data))
+		for k := range secret.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			// length prefixes keep the boundaries between keys and values
+			fmt.Fprintf(h, "%d:%s%d:", len(k), k, len(secret.Data[k]))
+			h.Write(secret.Data[k])
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
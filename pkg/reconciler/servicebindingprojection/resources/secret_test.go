@@ -0,0 +1,197 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMakeMappedSecret(t *testing.T) {
+	projection := func(mappings ...labsinternalv1alpha1.SecretMapping) *labsinternalv1alpha1.ServiceBindingProjection {
+		return &labsinternalv1alpha1.ServiceBindingProjection{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-binding",
+				Labels: map[string]string{
+					"servicebinding.io/servicebinding": "my-binding",
+				},
+			},
+			Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+				Binding: corev1.LocalObjectReference{
+					Name: "my-secret",
+				},
+				Mappings: mappings,
+			},
+		}
+	}
+	binding := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-secret",
+		},
+		Data: map[string][]byte{
+			"connection-string": []byte("postgres://db.example.com:5432"),
+			"host":              []byte("db.example.com"),
+			"username":          []byte("admin"),
+			"password":          []byte("secret"),
+		},
+	}
+	mappedSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-binding-mapped",
+				Labels: map[string]string{
+					"servicebinding.io/servicebinding": "my-binding",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         "internal.bindings.labs.vmware.com/v1alpha1",
+						Kind:               "ServiceBindingProjection",
+						Name:               "my-binding",
+						Controller:         ptr.Bool(true),
+						BlockOwnerDeletion: ptr.Bool(true),
+					},
+				},
+			},
+			Data: data,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		projection  *labsinternalv1alpha1.ServiceBindingProjection
+		binding     *corev1.Secret
+		expected    *corev1.Secret
+		expectedErr bool
+	}{
+		{
+			name: "rename, drop and derive",
+			projection: projection(
+				labsinternalv1alpha1.SecretMapping{Key: "uri", From: "connection-string"},
+				labsinternalv1alpha1.SecretMapping{Key: "password", Drop: true},
+				labsinternalv1alpha1.SecretMapping{Key: "credentials", Template: "{{ .username }}:{{ .password }}"},
+			),
+			binding: binding,
+			expected: mappedSecret(map[string][]byte{
+				"uri":         []byte("postgres://db.example.com:5432"),
+				"host":        []byte("db.example.com"),
+				"username":    []byte("admin"),
+				"credentials": []byte("admin:secret"),
+			}),
+		},
+		{
+			name: "swap keys",
+			projection: projection(
+				labsinternalv1alpha1.SecretMapping{Key: "username", From: "password"},
+				labsinternalv1alpha1.SecretMapping{Key: "password", From: "username"},
+			),
+			binding: binding,
+			expected: mappedSecret(map[string][]byte{
+				"connection-string": []byte("postgres://db.example.com:5432"),
+				"host":              []byte("db.example.com"),
+				"username":          []byte("secret"),
+				"password":          []byte("admin"),
+			}),
+		},
+		{
+			name: "missing key",
+			projection: projection(
+				labsinternalv1alpha1.SecretMapping{Key: "uri", From: "url"},
+			),
+			binding:     binding,
+			expectedErr: true,
+		},
+		{
+			name: "template references missing key",
+			projection: projection(
+				labsinternalv1alpha1.SecretMapping{Key: "uri", Template: "postgres://{{ .host }}:{{ .port }}"},
+			),
+			binding:     binding,
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := MakeMappedSecret(c.projection, c.binding)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("MakeMappedSecret() expected err %v, got %v", c.expectedErr, err)
+			}
+			if c.expectedErr {
+				return
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("MakeMappedSecret() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecretDataHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string][]byte
+		expected string
+	}{
+		{
+			name:     "empty",
+			expected: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		{
+			name: "single entry",
+			data: map[string][]byte{
+				"username": []byte("admin"),
+			},
+			expected: "c81b6974650e16694134681e725f3b776f7f67d5d691b4a53b64dc37df1d3400",
+		},
+		{
+			name: "sorted entries",
+			data: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("secret"),
+			},
+			expected: "8f9787c3e4250f6ea58faae3dc49eea6ec9e6f3f207db7e901d93dba0387a5d5",
+		},
+		{
+			name: "changed entry",
+			data: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("rotated"),
+			},
+			expected: "a1fc20050badc8a03cbbba879528ea2458181e570edfccd640d9c93af6bfb188",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := SecretDataHash(&corev1.Secret{Data: c.data})
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("SecretDataHash() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecretDataHash_MultipleSecrets(t *testing.T) {
+	db := &corev1.Secret{Data: map[string][]byte{"username": []byte("admin")}}
+	cache := &corev1.Secret{Data: map[string][]byte{"password": []byte("secret")}}
+
+	if SecretDataHash(db, cache) == SecretDataHash(db) {
+		t.Errorf("SecretDataHash() expected the hash to change with a second secret")
+	}
+	if SecretDataHash(db, cache) == SecretDataHash(cache, db) {
+		t.Errorf("SecretDataHash() expected the hash to depend on the order of the secrets")
+	}
+	empty := &corev1.Secret{}
+	if SecretDataHash(db, empty) == SecretDataHash(empty, db) {
+		t.Errorf("SecretDataHash() expected the hash to keep the boundaries between secrets")
+	}
+}
@@ -0,0 +1,741 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package servicebindingprojection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis/duck"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/tracker"
+	"knative.dev/pkg/webhook/psbinding"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	"github.com/vmware-tanzu/servicebinding/pkg/metrics"
+	"github.com/vmware-tanzu/servicebinding/pkg/reconciler/servicebindingprojection/resources"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
+	bindingwebhook "github.com/vmware-tanzu/servicebinding/pkg/webhook/binding"
+)
+
+// Reconciler implements controller.Reconciler for ServiceBindingProjection
+// resources. Workloads described by a ClusterWorkloadResourceMapping are bound
+// via their mapping, all other workloads are bound as PodSpecable resources.
+type Reconciler struct {
+	*psbinding.BaseReconciler
+
+	// resourceMapper resolves the resource serving the kind of the subject
+	resourceMapper *resolver.ResourceMapper
+	// mappingResolver resolves the mapping for a workload resource
+	mappingResolver *resolver.WorkloadMappingResolver
+	// unstructuredFactory produces listers for workload resources
+	unstructuredFactory duck.InformerFactory
+
+	kubeclient      kubernetes.Interface
+	secretLister    corev1listers.SecretLister
+	configMapLister corev1listers.ConfigMapLister
+
+	// workloadsReporter counts the workloads bound in the metrics
+	workloadsReporter *metrics.WorkloadsReporter
+}
+
+// Check that our Reconciler implements controller.Reconciler
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+// Reconcile implements controller.Reconciler
+//
+// Mirrors psbinding.BaseReconciler#Reconcile so that our ReconcileSubject is
+// used to bind the workload.
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	// Convert the namespace/name string into a distinct namespace and name
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		logging.FromContext(ctx).Error("invalid resource key: ", key)
+		return nil
+	}
+
+	// Only the leader should reconcile binding resources.
+	if !r.IsLeaderFor(types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}) {
+		return controller.NewSkipKey(key)
+	}
+
+	// Get the resource with this namespace/name.
+	original, err := r.Get(namespace, name)
+	if apierrs.IsNotFound(err) {
+		// The resource may no longer exist, in which case we stop processing.
+		logging.FromContext(ctx).Errorf("resource %q no longer exists", key)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	// Don't modify the informers copy.
+	resource := original.DeepCopyObject().(psbinding.Bindable)
+
+	// Reconcile this copy of the resource and then write back any status
+	// updates regardless of whether the reconciliation errored out.
+	reconcileErr := r.reconcile(ctx, resource)
+	if equality.Semantic.DeepEqual(original.GetBindingStatus(), resource.GetBindingStatus()) {
+		// If we didn't change anything then don't call updateStatus.
+	} else if resource.GetDeletionTimestamp() != nil && reconcileErr == nil {
+		// The binding has been undone and our finalizer removed, the
+		// resource is going away.
+	} else if err = r.UpdateStatus(ctx, resource); err != nil {
+		logging.FromContext(ctx).Warnw("Failed to update resource status", zap.Error(err))
+		r.Recorder.Eventf(resource, corev1.EventTypeWarning, "UpdateFailed",
+			"Failed to update status for %q: %v", resource.GetName(), err)
+		return err
+	}
+	if reconcileErr != nil {
+		r.Recorder.Event(resource, corev1.EventTypeWarning, "InternalError", reconcileErr.Error())
+	}
+	return reconcileErr
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, fb psbinding.Bindable) error {
+	if fb.GetDeletionTimestamp() != nil {
+		return r.ReconcileDeletion(ctx, fb)
+	}
+	// Make sure that our conditions have been initialized.
+	fb.GetBindingStatus().InitializeConditions()
+
+	// Make sure that the resource has a Finalizer configured, which
+	// enables us to undo our binding upon deletion.
+	if err := r.EnsureFinalizer(ctx, fb); err != nil {
+		return err
+	}
+
+	// Materialize the mapped binding secret before it is projected into the
+	// subject(s) of the Binding.
+	if err := r.reconcileMappedSecret(ctx, fb); err != nil {
+		return err
+	}
+
+	// Record the hash of the projected secret, applied to the subject(s) to
+	// roll them out when the secret changes.
+	if err := r.reconcileSecretHash(ctx, fb); err != nil {
+		return err
+	}
+
+	// Perform our Binding's Do() method on the subject(s) of the Binding.
+	if err := r.ReconcileSubject(ctx, fb, fb.Do); err != nil {
+		return err
+	}
+
+	// Update the observed generation once we have successfully reconciled
+	// our spec.
+	fb.GetBindingStatus().SetObservedGeneration(fb.GetGeneration())
+	return nil
+}
+
+// reconcileMappedSecret keeps the mapped copy of the binding secret in sync
+// with the binding secret and the mappings of the Binding. The mapped copy is
+// removed once the Binding no longer has mappings.
+func (r *Reconciler) reconcileMappedSecret(ctx context.Context, fb psbinding.Bindable) error {
+	projection, ok := fb.(*labsinternalv1alpha1.ServiceBindingProjection)
+	if !ok {
+		return nil
+	}
+	if len(projection.Spec.Mappings) == 0 {
+		return r.deleteMappedSecret(ctx, projection)
+	}
+
+	// Have the tracker queue this Binding whenever the binding secret
+	// changes.
+	ref := tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  projection.Namespace,
+		Name:       projection.Spec.Binding.Name,
+	}
+	if err := r.Tracker.TrackReference(ref, projection); err != nil {
+		logging.FromContext(ctx).Errorf("Error tracking binding secret %v: %v", ref, err)
+		return err
+	}
+
+	binding, err := r.secretLister.Secrets(projection.Namespace).Get(projection.Spec.Binding.Name)
+	if apierrs.IsNotFound(err) {
+		// we'll try again when the binding secret is created
+		projection.Status.MarkBindingUnavailable("BindingSecretMissing", err.Error())
+		return controller.NewPermanentError(err)
+	} else if err != nil {
+		return fmt.Errorf("failed to get Secret: %w", err)
+	}
+	desired, err := resources.MakeMappedSecret(projection, binding)
+	if err != nil {
+		// we'll try again when the binding secret or mappings change
+		projection.Status.MarkBindingUnavailable("MappingFailed", err.Error())
+		return controller.NewPermanentError(err)
+	}
+
+	secret, err := r.secretLister.Secrets(projection.Namespace).Get(desired.Name)
+	if apierrs.IsNotFound(err) {
+		if _, err := r.kubeclient.CoreV1().Secrets(projection.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			r.Recorder.Eventf(projection, corev1.EventTypeWarning, "CreationFailed", "Failed to create Secret %q: %v", desired.Name, err)
+			return fmt.Errorf("failed to create Secret: %w", err)
+		}
+		r.Recorder.Eventf(projection, corev1.EventTypeNormal, "Created", "Created Secret %q", desired.Name)
+	} else if err != nil {
+		return fmt.Errorf("failed to get Secret: %w", err)
+	} else if !metav1.IsControlledBy(secret, projection) {
+		return fmt.Errorf("ServiceBindingProjection %q does not own Secret: %q", projection.Name, desired.Name)
+	} else if !equality.Semantic.DeepEqual(desired.Data, secret.Data) || !equality.Semantic.DeepEqual(desired.Labels, secret.Labels) {
+		existing := secret.DeepCopy()
+		existing.Data = desired.Data
+		existing.Labels = desired.Labels
+		if _, err := r.kubeclient.CoreV1().Secrets(projection.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update Secret: %w", err)
+		}
+	}
+	return nil
+}
+
+// reconcileSecretHash records the hash of the projected secrets' data on the
+// Binding's status for Bindings that roll out their workloads when a secret
+// changes. The previous hash is kept while a secret is missing, so that the
+// workloads are not rolled out for a transient error.
+func (r *Reconciler) reconcileSecretHash(ctx context.Context, fb psbinding.Bindable) error {
+	projection, ok := fb.(*labsinternalv1alpha1.ServiceBindingProjection)
+	if !ok {
+		return nil
+	}
+	if !projection.Spec.RolloutOnSecretChange {
+		projection.Status.SetSecretHash("")
+		return nil
+	}
+
+	secrets := []*corev1.Secret{}
+	for i, name := range projection.ProjectedSecretNames() {
+		if i == 0 && projection.Spec.BindingKind == labsinternalv1alpha1.BindingKindConfigMap {
+			secret, err := r.projectedConfigMap(ctx, projection, name)
+			if err != nil || secret == nil {
+				return err
+			}
+			secrets = append(secrets, secret)
+			continue
+		}
+
+		// Have the tracker queue this Binding whenever the projected
+		// secret changes.
+		ref := tracker.Reference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Namespace:  projection.Namespace,
+			Name:       name,
+		}
+		if err := r.Tracker.TrackReference(ref, projection); err != nil {
+			logging.FromContext(ctx).Errorf("Error tracking projected secret %v: %v", ref, err)
+			return err
+		}
+
+		secret, err := r.secretLister.Secrets(projection.Namespace).Get(name)
+		if apierrs.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get Secret: %w", err)
+		}
+		secrets = append(secrets, secret)
+	}
+	projection.Status.SetSecretHash(resources.SecretDataHash(secrets...))
+	return nil
+}
+
+// projectedConfigMap reads the ConfigMap projected into the workload, as a
+// Secret with the same entries so that its data may be hashed. A missing
+// ConfigMap is nil.
+func (r *Reconciler) projectedConfigMap(ctx context.Context, projection *labsinternalv1alpha1.ServiceBindingProjection, name string) (*corev1.Secret, error) {
+	// Have the tracker queue this Binding whenever the projected config map
+	// changes.
+	ref := tracker.Reference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  projection.Namespace,
+		Name:       name,
+	}
+	if err := r.Tracker.TrackReference(ref, projection); err != nil {
+		logging.FromContext(ctx).Errorf("Error tracking projected config map %v: %v", ref, err)
+		return nil, err
+	}
+
+	configMap, err := r.configMapLister.ConfigMaps(projection.Namespace).Get(name)
+	if apierrs.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap: %w", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: configMap.Name},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range configMap.Data {
+		secret.Data[k] = []byte(v)
+	}
+	for k, v := range configMap.BinaryData {
+		secret.Data[k] = v
+	}
+	return secret, nil
+}
+
+// deleteMappedSecret removes a previously mapped copy of the binding secret.
+func (r *Reconciler) deleteMappedSecret(ctx context.Context, projection *labsinternalv1alpha1.ServiceBindingProjection) error {
+	secretName := projection.MappedSecretName()
+	secret, err := r.secretLister.Secrets(projection.Namespace).Get(secretName)
+	if apierrs.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get Secret: %w", err)
+	} else if !metav1.IsControlledBy(secret, projection) {
+		return nil
+	}
+	if err := r.kubeclient.CoreV1().Secrets(projection.Namespace).Delete(ctx, secretName, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Secret: %w", err)
+	}
+	r.Recorder.Eventf(projection, corev1.EventTypeNormal, "Deleted", "Deleted Secret %q", secretName)
+	return nil
+}
+
+// ReconcileDeletion undoes the binding and removes our finalizer.
+func (r *Reconciler) ReconcileDeletion(ctx context.Context, fb psbinding.Bindable) error {
+	// If we are not the controller finalizing this resource, then we
+	// are done.
+	if !r.IsFinalizing(ctx, fb) {
+		return nil
+	}
+
+	// If it is our turn to finalize the Binding, then first undo the effect
+	// of our Binding on the resource.
+	logging.FromContext(ctx).Info("Removing the binding for ", fb.GetName())
+	if err := r.ReconcileSubject(ctx, fb, fb.Undo); apierrs.IsNotFound(err) || apierrs.IsForbidden(err) || resolver.IsKindNotServed(err) {
+		// If the subject has been deleted, or its kind is no longer served,
+		// then there is nothing to undo.
+	} else if err != nil {
+		r.markUnbinding(fb, err)
+		return err
+	}
+
+	// Once the Binding has been undone, remove our finalizer allowing the
+	// Binding resource's deletion to progress.
+	return r.RemoveFinalizer(ctx, fb)
+}
+
+// markUnbinding reports the workloads the binding could not be removed from.
+func (r *Reconciler) markUnbinding(fb psbinding.Bindable, err error) {
+	status, ok := fb.GetBindingStatus().(*labsinternalv1alpha1.ServiceBindingProjectionStatus)
+	if !ok {
+		return
+	}
+	message := err.Error()
+	if summary := status.SummarizeWorkloads(); summary != nil {
+		message = fmt.Sprintf("%d of %d workloads still bound: %v", summary.Injected, summary.Matched, err)
+	}
+	status.MarkUnbinding("UnbindFailed", message)
+}
+
+// ReconcileSubject applies the mutation (Do or Undo) to the Binding's
+// subject(s). Subjects that are not mapped by a ClusterWorkloadResourceMapping
+// are handled as PodSpecable resources. Pods are only inspected, they are bound
+// by the webhook when created. The outcome for each subject is
+// recorded on the Binding's status. While the Binding is deleted, the
+// mutation is also applied to workloads recorded on the Binding's status that
+// no longer match the workload reference.
+func (r *Reconciler) ReconcileSubject(ctx context.Context, fb psbinding.Bindable, mutation psbinding.Mutation) error {
+	subject := fb.GetSubject()
+
+	// Determine the GroupVersionResource of the subject reference
+	gv, err := schema.ParseGroupVersion(subject.APIVersion)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("Error parsing GroupVersion %v: %v", subject.APIVersion, err)
+		return err
+	}
+	gvr, err := r.resourceMapper.ResourceFor(gv.WithKind(subject.Kind))
+	if resolver.IsKindNotServed(err) {
+		fb.GetBindingStatus().MarkBindingUnavailable("KindNotServed", err.Error())
+		return err
+	} else if err != nil {
+		logging.FromContext(ctx).Errorf("Error mapping kind %v: %v", subject.Kind, err)
+		return err
+	}
+
+	// Pods are bound by the webhook when they are created, their spec is
+	// immutable. The binding state of existing pods is only inspected.
+	admissionOnly := bindingwebhook.IsPod(gvr.GroupResource())
+
+	var template *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+	if !admissionOnly {
+		template, err = r.mappingResolver.TemplateForResource(ctx, gvr)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("Error resolving mapping for resource '%+v': %v", gvr, err)
+			return err
+		}
+	}
+
+	// Access the subject of our Binding and have the tracker queue this
+	// Bindable whenever it changes.
+	if err := r.Tracker.TrackReference(subject, fb); err != nil {
+		logging.FromContext(ctx).Errorf("Error tracking subject %v: %v", subject, err)
+		return err
+	}
+
+	// Use the GVR of the subject(s) to get ahold of a lister that we can
+	// use to fetch our workload resources.
+	_, lister, err := r.unstructuredFactory.Get(ctx, gvr)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("Error getting a lister for resource '%+v': %v", gvr, err)
+		fb.GetBindingStatus().MarkBindingUnavailable("SubjectUnavailable", err.Error())
+		return err
+	}
+
+	// Based on the type of subject reference, build up a list of referents.
+	var referents []*unstructured.Unstructured
+	if subject.Name != "" {
+		obj, err := lister.ByNamespace(subject.Namespace).Get(subject.Name)
+		if apierrs.IsNotFound(err) {
+			r.setWorkloads(ctx, fb, nil)
+			fb.GetBindingStatus().MarkBindingUnavailable("SubjectMissing", err.Error())
+			return err
+		} else if err != nil {
+			return fmt.Errorf("error fetching workload %v: %w", subject, err)
+		}
+		if err := r.labelNamespace(ctx, subject); err != nil {
+			return err
+		}
+		referents = append(referents, obj.(*unstructured.Unstructured))
+	} else {
+		selector, err := metav1.LabelSelectorAsSelector(subject.Selector)
+		if err != nil {
+			return err
+		}
+		objs, err := lister.ByNamespace(subject.Namespace).List(selector)
+		if err != nil {
+			return fmt.Errorf("error fetching workload %v: %w", subject, err)
+		}
+		if err := r.labelNamespace(ctx, subject); err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			referents = append(referents, obj.(*unstructured.Unstructured))
+		}
+	}
+
+	undo := fb.GetDeletionTimestamp() != nil
+	if undo {
+		tracked, err := r.trackedWorkloads(fb, lister, subject.Namespace, referents)
+		if err != nil {
+			return err
+		}
+		referents = append(referents, tracked...)
+	}
+
+	// Callback into the user's code to setup the context with additional
+	// information needed to perform the mutation.
+	if r.WithContext != nil {
+		ctx, err = r.WithContext(ctx, fb)
+		if err != nil {
+			return err
+		}
+	}
+
+	projection, _ := fb.(*labsinternalv1alpha1.ServiceBindingProjection)
+	if undo {
+		projection = nil
+	}
+
+	// For each of the referents, apply the mutation and capture the outcome.
+	workloads := make([]labsinternalv1alpha1.WorkloadStatus, len(referents))
+	missingContainers := make([][]string, len(referents))
+	eg := errgroup.Group{}
+	for i, u := range referents {
+		i, u := i, u
+		eg.Go(func() error {
+			var containers []string
+			var inspect func(context.Context, *duckv1.WithPod) error
+			if projection != nil {
+				inspect = func(ctx context.Context, ps *duckv1.WithPod) error {
+					containers = projection.InjectedContainers(ps)
+					missingContainers[i] = projection.MissingContainers(ps)
+					// Containers that already mount another volume at the
+					// binding's mount path are left unbound by Do
+					if collisions := projection.MountPathCollisions(ctx, ps); len(collisions) != 0 {
+						return &mountPathCollisionError{containers: collisions}
+					}
+					return nil
+				}
+			}
+			if admissionOnly {
+				unchanged, err := inspectPod(ctx, u, mutation, inspect)
+				injected := unchanged
+				if undo {
+					injected = !unchanged
+				}
+				workloads[i] = labsinternalv1alpha1.WorkloadStatus{
+					Name:               u.GetName(),
+					ObservedGeneration: u.GetGeneration(),
+					Injected:           injected,
+					AdmissionOnly:      true,
+				}
+				if undo {
+					// the binding remains in the pod until it is deleted
+					return nil
+				}
+				if injected {
+					workloads[i].Containers = containers
+				}
+				if err != nil {
+					workloads[i].Error = err.Error()
+				} else if !injected {
+					workloads[i].Error = "pod was not bound when created, recreate the pod to bind it"
+				}
+				return err
+			}
+			generation, err := r.mutateWorkload(ctx, gvr, template, u, mutation, inspect)
+			injected := err == nil
+			if undo {
+				// the binding remains in the workload until undone
+				injected = err != nil
+			}
+			workloads[i] = labsinternalv1alpha1.WorkloadStatus{
+				Name:               u.GetName(),
+				ObservedGeneration: generation,
+				Injected:           injected,
+			}
+			if injected {
+				workloads[i].Containers = containers
+			}
+			if err != nil {
+				workloads[i].Error = err.Error()
+			}
+			return err
+		})
+	}
+
+	// Based on the success of the referent binding, update the Binding's readiness.
+	err = eg.Wait()
+	r.setWorkloads(ctx, fb, workloads)
+	if projection != nil {
+		markContainersMatched(projection, workloads, missingContainers)
+	}
+	if err != nil {
+		var collision *mountPathCollisionError
+		if errors.As(err, &collision) {
+			// we'll try again when the workload or the binding change
+			fb.GetBindingStatus().MarkBindingUnavailable("MountPathCollision", err.Error())
+			return controller.NewPermanentError(err)
+		}
+		fb.GetBindingStatus().MarkBindingUnavailable("BindingFailed", err.Error())
+		return err
+	}
+	fb.GetBindingStatus().MarkBindingAvailable()
+	return nil
+}
+
+// setWorkloads records the per workload outcome on the Binding's status, and
+// the number of workloads bound in the metrics.
+func (r *Reconciler) setWorkloads(ctx context.Context, fb psbinding.Bindable, workloads []labsinternalv1alpha1.WorkloadStatus) {
+	if status, ok := fb.GetBindingStatus().(*labsinternalv1alpha1.ServiceBindingProjectionStatus); ok {
+		status.SetWorkloads(workloads)
+	}
+	bound := 0
+	for _, w := range workloads {
+		if w.Injected {
+			bound++
+		}
+	}
+	r.workloadsReporter.Observe(ctx, types.NamespacedName{Namespace: fb.GetNamespace(), Name: fb.GetName()}, bound)
+}
+
+// markContainersMatched reports containers named by the Binding's workload
+// reference that are not defined by a workload. Otherwise a misspelled name
+// silently binds nothing.
+func markContainersMatched(projection *labsinternalv1alpha1.ServiceBindingProjection, workloads []labsinternalv1alpha1.WorkloadStatus, missingContainers [][]string) {
+	w := projection.Spec.Workload
+	if len(w.Containers) == 0 && len(w.IncludeInitContainers) == 0 && len(w.IncludeEphemeralContainers) == 0 {
+		projection.Status.ClearContainersMatched()
+		return
+	}
+	var messages []string
+	for i, missing := range missingContainers {
+		if len(missing) != 0 {
+			messages = append(messages, fmt.Sprintf("workload %s does not define containers %s", workloads[i].Name, strings.Join(missing, ", ")))
+		}
+	}
+	if len(messages) == 0 {
+		projection.Status.MarkContainersMatched()
+		return
+	}
+	sort.Strings(messages)
+	projection.Status.MarkContainersNotMatched("ContainerNotFound", strings.Join(messages, "; "))
+}
+
+// trackedWorkloads returns the workloads recorded on the Binding's status that
+// are not among the referents. Workloads that no longer exist are skipped.
+func (r *Reconciler) trackedWorkloads(fb psbinding.Bindable, lister cache.GenericLister, namespace string, referents []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	status, ok := fb.GetBindingStatus().(*labsinternalv1alpha1.ServiceBindingProjectionStatus)
+	if !ok {
+		return nil, nil
+	}
+	names := sets.NewString()
+	for _, u := range referents {
+		names.Insert(u.GetName())
+	}
+	var tracked []*unstructured.Unstructured
+	for _, w := range status.Workloads {
+		if names.Has(w.Name) {
+			continue
+		}
+		obj, err := lister.ByNamespace(namespace).Get(w.Name)
+		if apierrs.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error fetching workload %s: %w", w.Name, err)
+		}
+		tracked = append(tracked, obj.(*unstructured.Unstructured))
+	}
+	return tracked, nil
+}
+
+// mutateWorkload applies the mutation to the workload, returning the
+// generation of the workload after the mutation. Workloads without a mapping
+// template are mutated as PodSpecable resources, like the webhook does. The
+// optional inspect func observes the mutated workload and reports problems
+// with it; the mutation is applied regardless.
+func (r *Reconciler) mutateWorkload(ctx context.Context, gvr schema.GroupVersionResource, template *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, orig *unstructured.Unstructured, mutation psbinding.Mutation, inspect func(context.Context, *duckv1.WithPod) error) (int64, error) {
+	generation := orig.GetGeneration()
+
+	var ps *duckv1.WithPod
+	if template == nil {
+		ps = &duckv1.WithPod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(orig.UnstructuredContent(), ps); err != nil {
+			return generation, fmt.Errorf("failed mapping subject %s: %w", orig.GetName(), err)
+		}
+	} else {
+		var err error
+		if ps, err = template.ExtractPodSpecable(orig); err != nil {
+			return generation, fmt.Errorf("failed mapping subject %s: %w", orig.GetName(), err)
+		}
+	}
+	before := ps.DeepCopy()
+	mutation(ctx, ps)
+	var invalid error
+	if inspect != nil {
+		if err := inspect(ctx, ps); err != nil {
+			invalid = fmt.Errorf("failed binding subject %s: %w", orig.GetName(), err)
+		}
+	}
+
+	// Compare the PodSpecable directly, or the workload the mapped fields are
+	// written back into.
+	var from, to interface{} = before, ps
+	if template != nil {
+		mutated := orig.DeepCopy()
+		if err := template.InjectPodSpecable(mutated, ps); err != nil {
+			return generation, fmt.Errorf("failed mapping subject %s: %w", orig.GetName(), err)
+		}
+		from, to = orig, mutated
+	}
+
+	// If nothing changed, then bail early.
+	if equality.Semantic.DeepEqual(from, to) {
+		return generation, invalid
+	}
+
+	// If we encountered changes, then synthesize and apply a patch.
+	patchBytes, err := duck.CreateBytePatch(from, to)
+	if err != nil {
+		return generation, err
+	}
+	patched, err := r.DynamicClient.Resource(gvr).Namespace(orig.GetNamespace()).Patch(
+		ctx, orig.GetName(), types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return generation, fmt.Errorf("failed binding subject %s: %w", orig.GetName(), err)
+	}
+	if patched != nil {
+		generation = patched.GetGeneration()
+	}
+	return generation, invalid
+}
+
+// inspectPod reports whether the mutation leaves the pod unchanged, without
+// updating the pod as its spec is immutable. The optional inspect func
+// observes the mutated pod and reports problems with it.
+func inspectPod(ctx context.Context, orig *unstructured.Unstructured, mutation psbinding.Mutation, inspect func(context.Context, *duckv1.WithPod) error) (bool, error) {
+	pod := &corev1.Pod{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(orig.UnstructuredContent(), pod); err != nil {
+		return false, fmt.Errorf("failed mapping subject %s: %w", orig.GetName(), err)
+	}
+	ps := bindingwebhook.ExtractPod(pod)
+	mutated := ps.DeepCopy()
+	mutation(ctx, mutated)
+	var invalid error
+	if inspect != nil {
+		if err := inspect(ctx, mutated); err != nil {
+			invalid = fmt.Errorf("failed binding subject %s: %w", orig.GetName(), err)
+		}
+	}
+	return equality.Semantic.DeepEqual(ps, mutated), invalid
+}
+
+// mountPathCollisionError reports the containers of a workload the binding
+// could not be mounted into, as another volume is mounted at the same path.
+type mountPathCollisionError struct {
+	containers []string
+}
+
+func (e *mountPathCollisionError) Error() string {
+	return fmt.Sprintf("binding mount path collides with an existing volume mount in containers %s", strings.Join(e.containers, ", "))
+}
+
+// labelNamespace mirrors psbinding.BaseReconciler#labelNamespace, opting the
+// namespace into the binding webhook.
+func (r *Reconciler) labelNamespace(ctx context.Context, subject tracker.Reference) error {
+	namespace, err := r.NamespaceLister.Get(subject.Namespace)
+	if err != nil {
+		logging.FromContext(ctx).Info("Error getting namespace: ", err)
+		return err
+	}
+
+	labels := namespace.GetLabels()
+	if labels[duck.BindingIncludeLabel] != "" || labels[duck.BindingExcludeLabel] != "" {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{duck.BindingIncludeLabel: "true"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	gvr := corev1.SchemeGroupVersion.WithResource("namespaces")
+	if _, err := r.DynamicClient.Resource(gvr).Patch(ctx, subject.Namespace, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		logging.FromContext(ctx).Infof("Error applying patch to namespace: %s: %v", subject.Namespace, err)
+		return err
+	}
+	return nil
+}
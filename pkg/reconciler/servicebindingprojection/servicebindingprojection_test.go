@@ -7,28 +7,45 @@ package servicebindingprojection
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+	"github.com/vmware-tanzu/servicebinding/pkg/metrics"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
-	"knative.dev/pkg/client/injection/ducks/duck/v1/podspecable"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	dynamicclient "knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/system"
 	"knative.dev/pkg/tracker"
 	"knative.dev/pkg/webhook/psbinding"
 
 	// register injection fakes
 	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/labsinternal/v1alpha1/servicebindingprojection/fake"
-	_ "knative.dev/pkg/client/injection/ducks/duck/v1/podspecable/fake"
+	_ "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/servicebinding/v1beta1/clusterworkloadresourcemapping/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/fake"
 	_ "knative.dev/pkg/injection/clients/dynamicclient/fake"
 
 	. "github.com/vmware-tanzu/servicebinding/pkg/reconciler/testing"
@@ -38,7 +55,17 @@ import (
 func TestNewController(t *testing.T) {
 	ctx, _ := SetupFakeContext(t)
 
-	c := NewController(ctx, configmap.NewStaticWatcher())
+	c := NewController(ctx, configmap.NewStaticWatcher(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.BindingConfigName,
+			Namespace: system.Namespace(),
+		},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      config.BindingTypesConfigName,
+			Namespace: system.Namespace(),
+		},
+	}))
 
 	if c == nil {
 		t.Fatal("expected NewController to return a non-nil value")
@@ -104,6 +131,12 @@ func TestReconcile(t *testing.T) {
 							},
 						},
 					},
+					Workloads: []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:     "my-workload",
+							Injected: true,
+						},
+					},
 				},
 			},
 			&appsv1.Deployment{
@@ -140,23 +173,1713 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
-	}}
-
-	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
-		ctx = podspecable.WithDuck(ctx)
-
-		c := &psbinding.BaseReconciler{
-			GVR: labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"),
-			Get: func(namespace string, name string) (psbinding.Bindable, error) {
-				return listers.GetServiceBindingProjectionLister().ServiceBindingProjections(namespace).Get(name)
-			},
-			DynamicClient: dynamicclient.Get(ctx),
-			Recorder: record.NewBroadcaster().NewRecorder(
-				scheme.Scheme, corev1.EventSource{Component: controllerAgentName}),
-			NamespaceLister: listers.GetNamespaceLister(),
-			Tracker:         GetTracker(ctx),
-			Factory:         podspecable.Get(ctx),
-		}
-		return c
-	}))
+	}, {
+		Name: "bind mapped workload",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			cronJobMapping,
+			mappedProjection(namespace, name),
+			&batchv1beta1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-workload",
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-secret",
+					},
+				},
+				Spec: batchv1beta1.CronJobSpec{
+					Schedule: "@hourly",
+					JobTemplate: batchv1beta1.JobTemplateSpec{
+						Spec: batchv1.JobSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  "my-container",
+											Image: "my-image",
+											Env: []corev1.EnvVar{
+												{
+													Name:  "SERVICE_BINDING_ROOT",
+													Value: "/bindings",
+												},
+											},
+										},
+									},
+									Volumes: []corev1.Volume{
+										{
+											Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											VolumeSource: corev1.VolumeSource{
+												Projected: &corev1.ProjectedVolumeSource{
+													Sources: []corev1.VolumeProjection{
+														{
+															Secret: &corev1.SecretProjection{
+																LocalObjectReference: corev1.LocalObjectReference{
+																	Name: "my-secret",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/jobTemplate/spec/template/spec/containers/0/volumeMounts","value":[{"mountPath":"/bindings/my-service","name":"binding-5c5a15a8b0b3e154d77746945e563ba40100681b","readOnly":true}]}]`),
+			},
+		},
+	}, {
+		Name: "nop - mapped workload in sync",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			cronJobMapping,
+			mappedProjection(namespace, name),
+			&batchv1beta1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-workload",
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-secret",
+					},
+				},
+				Spec: batchv1beta1.CronJobSpec{
+					Schedule: "@hourly",
+					JobTemplate: batchv1beta1.JobTemplateSpec{
+						Spec: batchv1.JobSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{
+											Name:  "my-container",
+											Image: "my-image",
+											Env: []corev1.EnvVar{
+												{
+													Name:  "SERVICE_BINDING_ROOT",
+													Value: "/bindings",
+												},
+											},
+											VolumeMounts: []corev1.VolumeMount{
+												{
+													Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+													MountPath: "/bindings/my-service",
+													ReadOnly:  true,
+												},
+											},
+										},
+									},
+									Volumes: []corev1.Volume{
+										{
+											Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											VolumeSource: corev1.VolumeSource{
+												Projected: &corev1.ProjectedVolumeSource{
+													Sources: []corev1.VolumeProjection{
+														{
+															Secret: &corev1.SecretProjection{
+																LocalObjectReference: corev1.LocalObjectReference{
+																	Name: "my-secret",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, {
+		Name: "bind mapped init containers matched by includeInitContainers",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			cronJobInitContainersMapping,
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := mappedProjection(namespace, name)
+				p.Spec.Workload.Containers = []string{"my-container"}
+				p.Spec.Workload.IncludeInitContainers = []string{"my-init-*"}
+				return p
+			}(),
+			&batchv1beta1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-workload",
+					Annotations: map[string]string{
+						"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-secret",
+					},
+				},
+				Spec: batchv1beta1.CronJobSpec{
+					Schedule: "@hourly",
+					JobTemplate: batchv1beta1.JobTemplateSpec{
+						Spec: batchv1.JobSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									InitContainers: []corev1.Container{
+										{
+											Name:  "my-init-container",
+											Image: "my-image",
+											Env: []corev1.EnvVar{
+												{
+													Name:  "SERVICE_BINDING_ROOT",
+													Value: "/bindings",
+												},
+											},
+										},
+									},
+									Containers: []corev1.Container{
+										{
+											Name:  "my-container",
+											Image: "my-image",
+											Env: []corev1.EnvVar{
+												{
+													Name:  "SERVICE_BINDING_ROOT",
+													Value: "/bindings",
+												},
+											},
+											VolumeMounts: []corev1.VolumeMount{
+												{
+													Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+													MountPath: "/bindings/my-service",
+													ReadOnly:  true,
+												},
+											},
+										},
+									},
+									Volumes: []corev1.Volume{
+										{
+											Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+											VolumeSource: corev1.VolumeSource{
+												Projected: &corev1.ProjectedVolumeSource{
+													Sources: []corev1.VolumeProjection{
+														{
+															Secret: &corev1.SecretProjection{
+																LocalObjectReference: corev1.LocalObjectReference{
+																	Name: "my-secret",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/jobTemplate/spec/template/spec/initContainers/0/volumeMounts","value":[{"mountPath":"/bindings/my-service","name":"binding-5c5a15a8b0b3e154d77746945e563ba40100681b","readOnly":true}]}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := mappedProjection(namespace, name)
+					p.Spec.Workload.Containers = []string{"my-container"}
+					p.Spec.Workload.IncludeInitContainers = []string{"my-init-*"}
+					p.Status.Workloads[0].Containers = []string{"my-init-container", "my-container"}
+					p.Status.MarkContainersMatched()
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "bind selected workloads",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			selectorProjection(namespace, name),
+			boundDeployment(namespace, "my-workload-1", 2, true),
+			boundDeployment(namespace, "my-workload-2", 1, false),
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "other-workload",
+				},
+			},
+		},
+		WithReactors: []clientgotesting.ReactionFunc{
+			InduceFailure("patch", "deployments"),
+		},
+		WantErr: true,
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload-2",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/template/spec/volumes","value":[{"name":"binding-5c5a15a8b0b3e154d77746945e563ba40100681b","projected":{"sources":[{"secret":{"name":"my-secret"}}]}}]}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := selectorProjection(namespace, name)
+					p.Status.MarkBindingUnavailable("BindingFailed", "failed binding subject my-workload-2: inducing failure for patch deployments")
+					p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:               "my-workload-1",
+							ObservedGeneration: 2,
+							Injected:           true,
+						},
+						{
+							Name:               "my-workload-2",
+							ObservedGeneration: 1,
+							Error:              "failed binding subject my-workload-2: inducing failure for patch deployments",
+						},
+					}
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "inspect pods without binding them",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			podProjection(namespace, name),
+			boundPod(namespace, "my-pod-1", true),
+			boundPod(namespace, "my-pod-2", false),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := podProjection(namespace, name)
+					p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:          "my-pod-1",
+							Injected:      true,
+							AdmissionOnly: true,
+						},
+						{
+							Name:          "my-pod-2",
+							AdmissionOnly: true,
+							Error:         "pod was not bound when created, recreate the pod to bind it",
+						},
+					}
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "pods remain bound when deleted",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := podProjection(namespace, name)
+				p.DeletionTimestamp = &metav1.Time{Time: time.Unix(1, 0)}
+				return p
+			}(),
+			boundPod(namespace, "my-pod-1", true),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      name,
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":[],"resourceVersion":""}}`),
+			},
+		},
+	}, {
+		Name: "subject kind not served",
+		Key:  key,
+		Objects: []runtime.Object{
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := selectorProjection(namespace, name)
+				p.Spec.Workload.APIVersion = "example.com/v1"
+				p.Spec.Workload.Kind = "Widget"
+				return p
+			}(),
+		},
+		WantErr: true,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := selectorProjection(namespace, name)
+					p.Spec.Workload.APIVersion = "example.com/v1"
+					p.Spec.Workload.Kind = "Widget"
+					p.Status.MarkBindingUnavailable("KindNotServed", `no resource is served for kind "Widget" in "example.com/v1"`)
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "subject kind not served when deleted",
+		Key:  key,
+		Objects: []runtime.Object{
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := selectorProjection(namespace, name)
+				p.DeletionTimestamp = &metav1.Time{Time: time.Unix(1, 0)}
+				p.Spec.Workload.APIVersion = "example.com/v1"
+				p.Spec.Workload.Kind = "Widget"
+				return p
+			}(),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      name,
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":[],"resourceVersion":""}}`),
+			},
+		},
+	}, {
+		Name: "creates mapped secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			mappingsProjection(namespace, name),
+			bindingSecret(namespace),
+			mappedDeployment(namespace),
+		},
+		WantCreates: []runtime.Object{
+			mappedSecret(namespace, name, map[string][]byte{
+				"uri":  []byte("postgres://db.example.com"),
+				"host": []byte("db.example.com"),
+			}),
+		},
+		PostConditions: []func(*testing.T, *TableRow){
+			AssertTrackingSecret(namespace, "my-secret"),
+		},
+	}, {
+		Name: "updates mapped secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			mappingsProjection(namespace, name),
+			bindingSecret(namespace),
+			mappedSecret(namespace, name, map[string][]byte{
+				"uri": []byte("postgres://stale.example.com"),
+			}),
+			mappedDeployment(namespace),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: mappedSecret(namespace, name, map[string][]byte{
+					"uri":  []byte("postgres://db.example.com"),
+					"host": []byte("db.example.com"),
+				}),
+			},
+		},
+	}, {
+		Name: "rolls out workload when secret changes",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			rolloutProjection(namespace, name, "stale-hash"),
+			bindingSecret(namespace),
+			boundDeployment(namespace, "my-workload", 1, true),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/template/metadata/annotations","value":{"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3-secret-hash":"4ff1a857749d1bc18af96ef1c0e8b6289ce0154471e8417d17b8530e98fc4a4e"}}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := rolloutProjection(namespace, name, "4ff1a857749d1bc18af96ef1c0e8b6289ce0154471e8417d17b8530e98fc4a4e")
+					p.Status.Workloads[0].ObservedGeneration = 1
+					return p
+				}()),
+			},
+		},
+		PostConditions: []func(*testing.T, *TableRow){
+			AssertTrackingSecret(namespace, "my-secret"),
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "rolls out workload when config map changes",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			configMapProjection(namespace, name, "stale-hash"),
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-config-map",
+				},
+				Data: map[string]string{
+					"connection-string": "postgres://db.example.com",
+					"host":              "db.example.com",
+					"password":          "secret",
+				},
+			},
+			configMapDeployment(namespace),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/template/metadata/annotations","value":{"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3-secret-hash":"4ff1a857749d1bc18af96ef1c0e8b6289ce0154471e8417d17b8530e98fc4a4e"}}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := configMapProjection(namespace, name, "4ff1a857749d1bc18af96ef1c0e8b6289ce0154471e8417d17b8530e98fc4a4e")
+					p.Status.Workloads[0].ObservedGeneration = 1
+					return p
+				}()),
+			},
+		},
+		PostConditions: []func(*testing.T, *TableRow){
+			AssertTrackingObject(corev1.SchemeGroupVersion.WithKind("ConfigMap"), namespace, "my-config-map"),
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "keeps secret hash while secret is missing",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			rolloutProjection(namespace, name, "my-hash"),
+			func() *appsv1.Deployment {
+				d := boundDeployment(namespace, "my-workload", 1, true)
+				d.Spec.Template.Annotations = map[string]string{
+					"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3-secret-hash": "my-hash",
+				}
+				return d
+			}(),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := rolloutProjection(namespace, name, "my-hash")
+					p.Status.Workloads[0].ObservedGeneration = 1
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "mount path collides with a workload volume mount",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			mountPathProjection(namespace, name),
+			collidingDeployment(namespace),
+		},
+		WantErr: true,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := mountPathProjection(namespace, name)
+					p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:               "my-workload",
+							ObservedGeneration: 1,
+							Error:              "failed binding subject my-workload: binding mount path collides with an existing volume mount in containers app",
+						},
+					}
+					p.Status.MarkBindingUnavailable("MountPathCollision", "failed binding subject my-workload: binding mount path collides with an existing volume mount in containers app")
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "reports containers not defined by the workload",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			containersProjection(namespace, name, "app", "ap"),
+			containersDeployment(namespace),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := containersProjection(namespace, name, "app", "ap")
+					p.Status.Workloads[0].ObservedGeneration = 1
+					p.Status.Workloads[0].Containers = []string{"app"}
+					p.Status.MarkContainersNotMatched("ContainerNotFound", "workload my-workload does not define containers ap")
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "reports containers matched",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := containersProjection(namespace, name, "app")
+				p.Status.MarkContainersNotMatched("ContainerNotFound", "workload my-workload does not define containers ap")
+				return p
+			}(),
+			containersDeployment(namespace),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := containersProjection(namespace, name, "app")
+					p.Status.Workloads[0].ObservedGeneration = 1
+					p.Status.Workloads[0].Containers = []string{"app"}
+					p.Status.MarkContainersMatched()
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "reports init containers matched",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := containersProjection(namespace, name, "app")
+				p.Spec.Workload.IncludeInitContainers = []string{"init-*"}
+				p.Status.MarkContainersNotMatched("ContainerNotFound", "workload my-workload does not define containers init-*")
+				return p
+			}(),
+			initContainersDeployment(namespace, "init-db", true),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := containersProjection(namespace, name, "app")
+					p.Spec.Workload.IncludeInitContainers = []string{"init-*"}
+					p.Status.Workloads[0].ObservedGeneration = 1
+					p.Status.Workloads[0].Containers = []string{"init-db", "app"}
+					p.Status.MarkContainersMatched()
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "reports init containers not defined by the workload",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := containersProjection(namespace, name, "app", "init-db")
+				p.Spec.Workload.IncludeInitContainers = []string{"migrate"}
+				return p
+			}(),
+			initContainersDeployment(namespace, "init-db", false),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := containersProjection(namespace, name, "app", "init-db")
+					p.Spec.Workload.IncludeInitContainers = []string{"migrate"}
+					p.Status.Workloads[0].ObservedGeneration = 1
+					p.Status.Workloads[0].Containers = []string{"app"}
+					p.Status.MarkContainersNotMatched("ContainerNotFound", "workload my-workload does not define containers migrate")
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "binds init containers matched by includeInitContainers",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := containersProjection(namespace, name, "app")
+				p.Spec.Workload.IncludeInitContainers = []string{"init-*"}
+				return p
+			}(),
+			initContainersDeployment(namespace, "init-db", false),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/template/spec/initContainers/0/volumeMounts","value":[{"mountPath":"/bindings/my-service","name":"binding-5c5a15a8b0b3e154d77746945e563ba40100681b","readOnly":true}]}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := containersProjection(namespace, name, "app")
+					p.Spec.Workload.IncludeInitContainers = []string{"init-*"}
+					p.Status.Workloads[0].ObservedGeneration = 1
+					p.Status.Workloads[0].Containers = []string{"init-db", "app"}
+					p.Status.MarkContainersMatched()
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "reports ephemeral containers matched",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := podProjection(namespace, name)
+				p.Spec.Workload.IncludeEphemeralContainers = []string{"debug*"}
+				return p
+			}(),
+			ephemeralContainersPod(namespace, "my-pod", "debugger"),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := podProjection(namespace, name)
+					p.Spec.Workload.IncludeEphemeralContainers = []string{"debug*"}
+					p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:          "my-pod",
+							Injected:      true,
+							AdmissionOnly: true,
+							Containers:    []string{"app", "debugger"},
+						},
+					}
+					p.Status.MarkContainersMatched()
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "reports ephemeral containers not defined by the workload",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := podProjection(namespace, name)
+				p.Spec.Workload.IncludeEphemeralContainers = []string{"debug*"}
+				return p
+			}(),
+			ephemeralContainersPod(namespace, "my-pod"),
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := podProjection(namespace, name)
+					p.Spec.Workload.IncludeEphemeralContainers = []string{"debug*"}
+					p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:          "my-pod",
+							Injected:      true,
+							AdmissionOnly: true,
+							Containers:    []string{"app"},
+						},
+					}
+					p.Status.MarkContainersNotMatched("ContainerNotFound", "workload my-pod does not define containers debug*")
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "bind init containers of a PodSpecable workload",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			containersProjection(namespace, name),
+			initContainersDeployment(namespace, "init", false),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"add","path":"/spec/template/spec/initContainers/0/volumeMounts","value":[{"mountPath":"/bindings/my-service","name":"binding-5c5a15a8b0b3e154d77746945e563ba40100681b","readOnly":true}]}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := containersProjection(namespace, name)
+					p.Status.Workloads[0].ObservedGeneration = 1
+					p.Status.Workloads[0].Containers = []string{"init", "app"}
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "nop - PodSpecable workload with init containers in sync",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			func() *labsinternalv1alpha1.ServiceBindingProjection {
+				p := containersProjection(namespace, name)
+				p.Status.Workloads[0].ObservedGeneration = 1
+				p.Status.Workloads[0].Containers = []string{"init", "app"}
+				return p
+			}(),
+			initContainersDeployment(namespace, "init", true),
+		},
+	}, {
+		Name: "binding secret missing",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			mappingsProjection(namespace, name),
+			mappedDeployment(namespace),
+		},
+		WantErr: true,
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := mappingsProjection(namespace, name)
+					p.Status.MarkBindingUnavailable("BindingSecretMissing", `secret "my-secret" not found`)
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}, {
+		Name: "deletes mapped secret",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			selectorProjection(namespace, name),
+			mappedSecret(namespace, name, map[string][]byte{
+				"uri": []byte("postgres://db.example.com"),
+			}),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+					Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+				},
+				Name: "my-service-mapped",
+			},
+		},
+	}, {
+		Name: "unbind tracked workloads when deleted",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			deletedProjection(namespace, name),
+			unboundDeployment(namespace, "my-workload-1"),
+			// no longer selected, but still bound
+			unlabeledDeployment(namespace, "my-workload-2"),
+		},
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload-2",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"remove","path":"/spec/template/spec/volumes"}]`),
+			},
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      name,
+				PatchType: types.MergePatchType,
+				Patch:     []byte(`{"metadata":{"finalizers":[],"resourceVersion":""}}`),
+			},
+		},
+	}, {
+		Name: "unbind failed when deleted",
+		Key:  key,
+		Objects: []runtime.Object{
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: namespace,
+					Labels: map[string]string{
+						"bindings.knative.dev/include": "true",
+					},
+				},
+			},
+			deletedProjection(namespace, name),
+			unboundDeployment(namespace, "my-workload-1"),
+			unlabeledDeployment(namespace, "my-workload-2"),
+		},
+		WithReactors: []clientgotesting.ReactionFunc{
+			InduceFailure("patch", "deployments"),
+		},
+		WantErr: true,
+		WantPatches: []clientgotesting.PatchActionImpl{
+			{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: namespace,
+				},
+				Name:      "my-workload-2",
+				PatchType: types.JSONPatchType,
+				Patch:     []byte(`[{"op":"remove","path":"/spec/template/spec/volumes"}]`),
+			},
+		},
+		WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+			{
+				Object: unstructuredProjection(func() *labsinternalv1alpha1.ServiceBindingProjection {
+					p := deletedProjection(namespace, name)
+					p.Status.MarkBindingUnavailable("BindingFailed", "failed binding subject my-workload-2: inducing failure for patch deployments")
+					p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+						{
+							Name:               "my-workload-1",
+							ObservedGeneration: 1,
+						},
+						{
+							Name:               "my-workload-2",
+							ObservedGeneration: 1,
+							Injected:           true,
+							Error:              "failed binding subject my-workload-2: inducing failure for patch deployments",
+						},
+					}
+					p.Status.MarkUnbinding("UnbindFailed", "1 of 2 workloads still bound: failed binding subject my-workload-2: inducing failure for patch deployments")
+					return p
+				}()),
+			},
+		},
+		// status updates are made with the dynamic client
+		CmpOpts: []cmp.Option{
+			cmpopts.IgnoreMapEntries(func(k string, v interface{}) bool {
+				return k == "lastTransitionTime"
+			}),
+		},
+	}}
+
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		c := &Reconciler{
+			BaseReconciler: &psbinding.BaseReconciler{
+				GVR: labsinternalv1alpha1.SchemeGroupVersion.WithResource("servicebindingprojections"),
+				Get: func(namespace string, name string) (psbinding.Bindable, error) {
+					return listers.GetServiceBindingProjectionLister().ServiceBindingProjections(namespace).Get(name)
+				},
+				DynamicClient: dynamicclient.Get(ctx),
+				Recorder: record.NewBroadcaster().NewRecorder(
+					scheme.Scheme, corev1.EventSource{Component: controllerAgentName}),
+				NamespaceLister: listers.GetNamespaceLister(),
+				Tracker:         GetTracker(ctx),
+			},
+			resourceMapper:  resolver.NewResourceMapper(kubeclient.Get(ctx).Discovery()),
+			mappingResolver: resolver.NewWorkloadMappingResolver(listers.GetClusterWorkloadResourceMappingLister()),
+			unstructuredFactory: &resolver.UnstructuredInformerFactory{
+				Client:      dynamicclient.Get(ctx),
+				StopChannel: ctx.Done(),
+			},
+			kubeclient:        kubeclient.Get(ctx),
+			secretLister:      listers.GetSecretLister(),
+			configMapLister:   listers.GetConfigMapLister(),
+			workloadsReporter: metrics.NewWorkloadsReporter(),
+		}
+		return c
+	}))
+}
+
+var cronJobMapping = &servicebindingv1beta1.ClusterWorkloadResourceMapping{
+	ObjectMeta: metav1.ObjectMeta{
+		Name: "cronjobs.batch",
+	},
+	Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+		Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+			{
+				Version:     "*",
+				Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+						Name: ".name",
+					},
+				},
+				Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+			},
+		},
+	},
+}
+
+var cronJobInitContainersMapping = func() *servicebindingv1beta1.ClusterWorkloadResourceMapping {
+	m := cronJobMapping.DeepCopy()
+	m.Spec.Versions[0].Containers = append(m.Spec.Versions[0].Containers, servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+		Path: ".spec.jobTemplate.spec.template.spec.initContainers[*]",
+		Name: ".name",
+	})
+	return m
+}()
+
+func mappedProjection(namespace, name string) *labsinternalv1alpha1.ServiceBindingProjection {
+	return &labsinternalv1alpha1.ServiceBindingProjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Finalizers: []string{
+				"servicebindingprojections.internal.bindings.labs.vmware.com",
+			},
+			Generation: 1,
+		},
+		Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+			Name: name,
+			Workload: labsinternalv1alpha1.WorkloadReference{
+				Reference: tracker.Reference{
+					APIVersion: "batch/v1beta1",
+					Kind:       "CronJob",
+					Name:       "my-workload",
+				},
+			},
+			Binding: corev1.LocalObjectReference{
+				Name: "my-secret",
+			},
+		},
+		Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+			Status: duckv1.Status{
+				ObservedGeneration: 1,
+				Conditions: duckv1.Conditions{
+					{
+						Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+						Status: corev1.ConditionTrue,
+					},
+					{
+						Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionWorkloadAvailable,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+			Workloads: []labsinternalv1alpha1.WorkloadStatus{
+				{
+					Name:       "my-workload",
+					Injected:   true,
+					Containers: []string{"my-container"},
+				},
+			},
+		},
+	}
+}
+
+func selectorProjection(namespace, name string) *labsinternalv1alpha1.ServiceBindingProjection {
+	return &labsinternalv1alpha1.ServiceBindingProjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Finalizers: []string{
+				"servicebindingprojections.internal.bindings.labs.vmware.com",
+			},
+			Generation: 1,
+		},
+		Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+			Name: name,
+			Workload: labsinternalv1alpha1.WorkloadReference{
+				Reference: tracker.Reference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "my-app",
+						},
+					},
+				},
+			},
+			Binding: corev1.LocalObjectReference{
+				Name: "my-secret",
+			},
+		},
+		Status: labsinternalv1alpha1.ServiceBindingProjectionStatus{
+			Status: duckv1.Status{
+				ObservedGeneration: 1,
+				Conditions: duckv1.Conditions{
+					{
+						Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionReady,
+						Status: corev1.ConditionTrue,
+					},
+					{
+						Type:   labsinternalv1alpha1.ServiceBindingProjectionConditionWorkloadAvailable,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		},
+	}
+}
+
+// boundDeployment returns a deployment selected by selectorProjection, with
+// or without the binding volume
+func boundDeployment(namespace, name string, generation int64, withVolume bool) *appsv1.Deployment {
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       name,
+			Generation: generation,
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+			Annotations: map[string]string{
+				"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-secret",
+			},
+		},
+	}
+	if withVolume {
+		d.Spec.Template.Spec.Volumes = []corev1.Volume{
+			{
+				Name: "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{
+								Secret: &corev1.SecretProjection{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "my-secret",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	return d
+}
+
+// podProjection returns a projection selecting pods
+func podProjection(namespace, name string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := selectorProjection(namespace, name)
+	p.Spec.Workload.APIVersion = "v1"
+	p.Spec.Workload.Kind = "Pod"
+	return p
+}
+
+// boundPod returns a pod selected by podProjection, created with or without
+// the binding
+func boundPod(namespace, name string, bound bool) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				"app": "my-app",
+			},
+		},
+	}
+	if bound {
+		p.Annotations = map[string]string{
+			"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-secret",
+		}
+		p.Spec.Volumes = boundDeployment(namespace, name, 1, true).Spec.Template.Spec.Volumes
+	}
+	return p
+}
+
+// ephemeralContainersPod returns a pod selected by podProjection, created
+// with the binding in its container and in each of the ephemeral containers
+func ephemeralContainersPod(namespace, name string, ephemeralContainers ...string) *corev1.Pod {
+	p := boundPod(namespace, name, true)
+	p.Spec.Containers = containersDeployment(namespace).Spec.Template.Spec.Containers
+	for _, c := range ephemeralContainers {
+		p.Spec.EphemeralContainers = append(p.Spec.EphemeralContainers, corev1.EphemeralContainer{
+			EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+				Name:         c,
+				Env:          p.Spec.Containers[0].Env,
+				VolumeMounts: p.Spec.Containers[0].VolumeMounts,
+			},
+		})
+	}
+	return p
+}
+
+// deletedProjection returns a deleted projection that was bound to two
+// workloads
+func deletedProjection(namespace, name string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := selectorProjection(namespace, name)
+	p.DeletionTimestamp = &metav1.Time{Time: time.Unix(1, 0)}
+	p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+		{
+			Name:     "my-workload-1",
+			Injected: true,
+		},
+		{
+			Name:     "my-workload-2",
+			Injected: true,
+		},
+	}
+	return p
+}
+
+// unlabeledDeployment returns a deployment bound to the secret of
+// selectorProjection that is not selected by the projection
+func unlabeledDeployment(namespace, name string) *appsv1.Deployment {
+	d := boundDeployment(namespace, name, 1, true)
+	d.Labels = nil
+	d.Annotations = map[string]string{
+		"deployment.kubernetes.io/revision": "1",
+	}
+	return d
+}
+
+// unboundDeployment returns a deployment selected by selectorProjection that
+// the binding has been removed from
+func unboundDeployment(namespace, name string) *appsv1.Deployment {
+	d := boundDeployment(namespace, name, 1, false)
+	d.Annotations = map[string]string{
+		"deployment.kubernetes.io/revision": "1",
+	}
+	return d
+}
+
+// mappingsProjection returns a projection that maps the entries of the
+// binding secret
+func mappingsProjection(namespace, name string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := selectorProjection(namespace, name)
+	p.Spec.Workload.Selector = nil
+	p.Spec.Workload.Name = "my-workload"
+	p.Spec.Mappings = []labsinternalv1alpha1.SecretMapping{
+		{Key: "uri", From: "connection-string"},
+		{Key: "password", Drop: true},
+	}
+	p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+		{
+			Name:     "my-workload",
+			Injected: true,
+		},
+	}
+	return p
+}
+
+// mountPathProjection returns a projection that mounts the binding at a
+// fixed path
+func mountPathProjection(namespace, name string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := selectorProjection(namespace, name)
+	p.Spec.Workload.Selector = nil
+	p.Spec.Workload.Name = "my-workload"
+	p.Spec.MountPath = "/etc/db"
+	p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+		{
+			Name:     "my-workload",
+			Injected: true,
+		},
+	}
+	return p
+}
+
+// collidingDeployment returns a deployment bound to mountPathProjection that
+// already mounts another volume at the binding's mount path
+func collidingDeployment(namespace string) *appsv1.Deployment {
+	d := boundDeployment(namespace, "my-workload", 1, true)
+	d.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name: "app",
+			Env: []corev1.EnvVar{
+				{
+					Name:  "SERVICE_BINDING_ROOT",
+					Value: "/bindings",
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "config",
+					MountPath: "/etc/db",
+				},
+			},
+		},
+	}
+	return d
+}
+
+// containersProjection returns a projection that targets the named
+// containers of the workload
+func containersProjection(namespace, name string, containers ...string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := selectorProjection(namespace, name)
+	p.Spec.Workload.Selector = nil
+	p.Spec.Workload.Name = "my-workload"
+	p.Spec.Workload.Containers = containers
+	p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+		{
+			Name:     "my-workload",
+			Injected: true,
+		},
+	}
+	return p
+}
+
+// containersDeployment returns a deployment bound to containersProjection
+// that defines a single container
+func containersDeployment(namespace string) *appsv1.Deployment {
+	d := boundDeployment(namespace, "my-workload", 1, true)
+	d.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name: "app",
+			Env: []corev1.EnvVar{
+				{
+					Name:  "SERVICE_BINDING_ROOT",
+					Value: "/bindings",
+				},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+					MountPath: "/bindings/my-service",
+					ReadOnly:  true,
+				},
+			},
+		},
+	}
+	return d
+}
+
+// initContainersDeployment returns a deployment selected by
+// containersProjection with a bound container and an init container. The init
+// container's volume mount is only present when bound.
+func initContainersDeployment(namespace, initContainer string, bound bool) *appsv1.Deployment {
+	d := boundDeployment(namespace, "my-workload", 1, true)
+	env := []corev1.EnvVar{
+		{
+			Name:  "SERVICE_BINDING_ROOT",
+			Value: "/bindings",
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{
+			Name:      "binding-5c5a15a8b0b3e154d77746945e563ba40100681b",
+			MountPath: "/bindings/my-service",
+			ReadOnly:  true,
+		},
+	}
+	d.Spec.Template.Spec.InitContainers = []corev1.Container{
+		{
+			Name: initContainer,
+			Env:  env,
+		},
+	}
+	d.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name:         "app",
+			Env:          env,
+			VolumeMounts: mounts,
+		},
+	}
+	if bound {
+		d.Spec.Template.Spec.InitContainers[0].VolumeMounts = mounts
+	}
+	return d
+}
+
+// rolloutProjection returns a projection that rolls out the workload when
+// the binding secret changes
+func rolloutProjection(namespace, name, secretHash string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := selectorProjection(namespace, name)
+	p.Spec.Workload.Selector = nil
+	p.Spec.Workload.Name = "my-workload"
+	p.Spec.RolloutOnSecretChange = true
+	p.Status.SecretHash = secretHash
+	p.Status.Workloads = []labsinternalv1alpha1.WorkloadStatus{
+		{
+			Name:     "my-workload",
+			Injected: true,
+		},
+	}
+	return p
+}
+
+// configMapProjection returns a projection of a ConfigMap binding that rolls
+// out its workload
+func configMapProjection(namespace, name, secretHash string) *labsinternalv1alpha1.ServiceBindingProjection {
+	p := rolloutProjection(namespace, name, secretHash)
+	p.Spec.Binding.Name = "my-config-map"
+	p.Spec.BindingKind = labsinternalv1alpha1.BindingKindConfigMap
+	return p
+}
+
+func configMapDeployment(namespace string) *appsv1.Deployment {
+	d := boundDeployment(namespace, "my-workload", 1, false)
+	d.Annotations = map[string]string{
+		"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-config-map",
+	}
+	d.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "binding-f6d8ba6acbc9f57fcf56115e40a9ac3a0c8b93b4",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							ConfigMap: &corev1.ConfigMapProjection{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "my-config-map",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return d
+}
+
+func bindingSecret(namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-secret",
+		},
+		Data: map[string][]byte{
+			"connection-string": []byte("postgres://db.example.com"),
+			"host":              []byte("db.example.com"),
+			"password":          []byte("secret"),
+		},
+	}
+}
+
+func mappedSecret(namespace, name string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      fmt.Sprintf("%s-mapped", name),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "internal.bindings.labs.vmware.com/v1alpha1",
+					Kind:               "ServiceBindingProjection",
+					Name:               name,
+					Controller:         ptr.Bool(true),
+					BlockOwnerDeletion: ptr.Bool(true),
+				},
+			},
+		},
+		Data: data,
+	}
+}
+
+// mappedDeployment returns a deployment bound to the mapped secret of
+// mappingsProjection
+func mappedDeployment(namespace string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "my-workload",
+			Annotations: map[string]string{
+				"internal.bindings.labs.vmware.com/projection-e9ead9b18f311f72f9c7a54af76427b50d02e2e3": "my-service-mapped",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{
+						{
+							Name: "binding-675502900b5a66648b30e4470fb11f1beddc9b2b",
+							VolumeSource: corev1.VolumeSource{
+								Projected: &corev1.ProjectedVolumeSource{
+									Sources: []corev1.VolumeProjection{
+										{
+											Secret: &corev1.SecretProjection{
+												LocalObjectReference: corev1.LocalObjectReference{
+													Name: "my-service-mapped",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func unstructuredProjection(p *labsinternalv1alpha1.ServiceBindingProjection) *unstructured.Unstructured {
+	p = p.DeepCopy()
+	p.SetGroupVersionKind(p.GetGroupVersionKind())
+	b, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(b); err != nil {
+		panic(err)
+	}
+	return u
 }
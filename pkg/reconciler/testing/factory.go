@@ -11,12 +11,15 @@ package testing
 import (
 	"context"
 	"encoding/json"
+	"reflect"
+	"sort"
 	"testing"
 
 	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	fakeservicebindingsclient "github.com/vmware-tanzu/servicebinding/pkg/client/injection/client/fake"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -57,6 +60,7 @@ func MakeFactory(ctor Ctor) rtesting.Factory {
 		ctx = logging.WithLogger(ctx, logger)
 
 		ctx, kubeClient := fakekubeclient.With(ctx, ls.GetKubeObjects()...)
+		kubeClient.Resources = DiscoveryResources(ls.NewScheme())
 		ctx, servicebindingsClient := fakeservicebindingsclient.With(ctx, ls.GetServiceBindingsObjects()...)
 		ctx, dynamicClient := fakedynamicclient.With(ctx, ls.NewScheme(), ToUnstructured(t, ls.NewScheme(), r.Objects)...)
 		ctx = context.WithValue(ctx, TrackerKey, &rtesting.FakeTracker{})
@@ -108,13 +112,30 @@ func MakeFactory(ctor Ctor) rtesting.Factory {
 			return rtesting.ValidateUpdates(context.Background(), action)
 		})
 
-		actionRecorderList := rtesting.ActionRecorderList{dynamicClient, servicebindingsClient, kubeClient}
+		actionRecorderList := rtesting.ActionRecorderList{dynamicClient, servicebindingsClient, withoutDiscovery{kubeClient}}
 		eventList := rtesting.EventList{Recorder: eventRecorder}
 
 		return c, actionRecorderList, eventList
 	}
 }
 
+// withoutDiscovery hides the discovery requests recorded by the fake client,
+// which are not actions on resources.
+type withoutDiscovery struct {
+	rtesting.ActionRecorder
+}
+
+func (r withoutDiscovery) Actions() []ktesting.Action {
+	actions := []ktesting.Action{}
+	for _, action := range r.ActionRecorder.Actions() {
+		if _, ok := action.(ktesting.ActionImpl); ok && action.GetVerb() == "get" {
+			continue
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
 // ToUnstructured takes a list of k8s resources and converts them to
 // Unstructured objects.
 // We must pass objects as Unstructured to the dynamic client fake, or it
@@ -148,6 +169,32 @@ func ToUnstructured(t *testing.T, sch *runtime.Scheme, objs []runtime.Object) (u
 	return
 }
 
+// DiscoveryResources describes the resources of each kind registered with the
+// scheme, as the cluster would serve them through discovery.
+func DiscoveryResources(sch *runtime.Scheme) []*metav1.APIResourceList {
+	resources := map[schema.GroupVersion][]metav1.APIResource{}
+	for gvk, t := range sch.AllKnownTypes() {
+		if _, ok := reflect.New(t).Interface().(metav1.Object); !ok {
+			// lists, options and events are not resources
+			continue
+		}
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		resources[gvk.GroupVersion()] = append(resources[gvk.GroupVersion()], metav1.APIResource{
+			Name:       gvr.Resource,
+			Namespaced: true,
+			Kind:       gvk.Kind,
+			Verbs:      metav1.Verbs{"get", "list", "watch", "create", "update", "patch", "delete"},
+		})
+	}
+	lists := make([]*metav1.APIResourceList, 0, len(resources))
+	for gv, r := range resources {
+		sort.Slice(r, func(i, j int) bool { return r[i].Name < r[j].Name })
+		lists = append(lists, &metav1.APIResourceList{GroupVersion: gv.String(), APIResources: r})
+	}
+	sort.Slice(lists, func(i, j int) bool { return lists[i].GroupVersion < lists[j].GroupVersion })
+	return lists
+}
+
 type key struct{}
 
 // TrackerKey is used to looking a FakeTracker in a context.Context
@@ -12,10 +12,12 @@ import (
 	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
 	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
 	fakeservicebindingsclientset "github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/fake"
 	labslisters "github.com/vmware-tanzu/servicebinding/pkg/client/listers/labs/v1alpha1"
 	labsinternallisters "github.com/vmware-tanzu/servicebinding/pkg/client/listers/labsinternal/v1alpha1"
 	servicebindinglisters "github.com/vmware-tanzu/servicebinding/pkg/client/listers/servicebinding/v1alpha3"
+	servicebindingv1beta1listers "github.com/vmware-tanzu/servicebinding/pkg/client/listers/servicebinding/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
@@ -78,6 +80,10 @@ func (l *Listers) GetSecretLister() corev1listers.SecretLister {
 	return corev1listers.NewSecretLister(l.IndexerFor(&corev1.Secret{}))
 }
 
+func (l *Listers) GetConfigMapLister() corev1listers.ConfigMapLister {
+	return corev1listers.NewConfigMapLister(l.IndexerFor(&corev1.ConfigMap{}))
+}
+
 func (l *Listers) GetServiceBindingLister() servicebindinglisters.ServiceBindingLister {
 	return servicebindinglisters.NewServiceBindingLister(l.IndexerFor(&servicebindingv1alpha3.ServiceBinding{}))
 }
@@ -89,3 +95,11 @@ func (l *Listers) GetServiceBindingProjectionLister() labsinternallisters.Servic
 func (l *Listers) GetProvisionedServiceLister() labslisters.ProvisionedServiceLister {
 	return labslisters.NewProvisionedServiceLister(l.IndexerFor(&labsv1alpha1.ProvisionedService{}))
 }
+
+func (l *Listers) GetServiceBindingGrantLister() labslisters.ServiceBindingGrantLister {
+	return labslisters.NewServiceBindingGrantLister(l.IndexerFor(&labsv1alpha1.ServiceBindingGrant{}))
+}
+
+func (l *Listers) GetClusterWorkloadResourceMappingLister() servicebindingv1beta1listers.ClusterWorkloadResourceMappingLister {
+	return servicebindingv1beta1listers.NewClusterWorkloadResourceMappingLister(l.IndexerFor(&servicebindingv1beta1.ClusterWorkloadResourceMapping{}))
+}
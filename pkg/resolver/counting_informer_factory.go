@@ -0,0 +1,65 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis/duck"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/metrics"
+)
+
+// CountingInformerFactory implements duck.InformerFactory, recording the
+// number of resources cached by each informer the delegate produces. The
+// informer for a resource is counted once, no matter how often it is
+// requested, the delegate is expected to return the same informer for a
+// resource, like a duck.CachedInformerFactory does.
+type CountingInformerFactory struct {
+	// Name identifies the informers in the metrics
+	Name     string
+	Delegate duck.InformerFactory
+
+	m       sync.Mutex
+	counted map[schema.GroupVersionResource]bool
+}
+
+// Check that CountingInformerFactory implements InformerFactory.
+var _ duck.InformerFactory = (*CountingInformerFactory)(nil)
+
+// Get implements duck.InformerFactory.
+func (f *CountingInformerFactory) Get(ctx context.Context, gvr schema.GroupVersionResource) (cache.SharedIndexInformer, cache.GenericLister, error) {
+	inf, lister, err := f.Delegate.Get(ctx, gvr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.counted[gvr] {
+		return inf, lister, nil
+	}
+	if f.counted == nil {
+		f.counted = map[schema.GroupVersionResource]bool{}
+	}
+	f.counted[gvr] = true
+
+	var count int64
+	inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) {
+			metrics.RecordInformerCache(ctx, f.Name, gvr, atomic.AddInt64(&count, 1))
+		},
+		DeleteFunc: func(interface{}) {
+			metrics.RecordInformerCache(ctx, f.Name, gvr, atomic.AddInt64(&count, -1))
+		},
+	})
+
+	return inf, lister, nil
+}
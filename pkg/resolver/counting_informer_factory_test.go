@@ -0,0 +1,76 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis/duck"
+)
+
+func TestCountingInformerFactory(t *testing.T) {
+	deployments := appsv1.SchemeGroupVersion.WithResource("deployments")
+	replicasets := appsv1.SchemeGroupVersion.WithResource("replicasets")
+
+	delegate := &fakeInformerFactory{informers: map[schema.GroupVersionResource]*handlerCountingInformer{}}
+	factory := &CountingInformerFactory{
+		Name:     "test",
+		Delegate: delegate,
+	}
+
+	ctx := context.TODO()
+	for _, gvr := range []schema.GroupVersionResource{deployments, deployments, replicasets, deployments} {
+		if _, _, err := factory.Get(ctx, gvr); err != nil {
+			t.Fatalf("Get(%s) unexpected err %v", gvr, err)
+		}
+	}
+	for _, gvr := range []schema.GroupVersionResource{deployments, replicasets} {
+		if expected, actual := 1, delegate.informers[gvr].handlers; expected != actual {
+			t.Errorf("Get(%s) expected %d counting handler, got %d", gvr, expected, actual)
+		}
+	}
+
+	delegate.err = fmt.Errorf("informer failed")
+	if _, _, err := factory.Get(ctx, appsv1.SchemeGroupVersion.WithResource("statefulsets")); err == nil {
+		t.Errorf("Get() expected err")
+	}
+}
+
+// fakeInformerFactory returns the same informer for each resource, like a
+// duck.CachedInformerFactory
+type fakeInformerFactory struct {
+	informers map[schema.GroupVersionResource]*handlerCountingInformer
+	err       error
+}
+
+var _ duck.InformerFactory = (*fakeInformerFactory)(nil)
+
+func (f *fakeInformerFactory) Get(ctx context.Context, gvr schema.GroupVersionResource) (cache.SharedIndexInformer, cache.GenericLister, error) {
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	inf, ok := f.informers[gvr]
+	if !ok {
+		inf = &handlerCountingInformer{}
+		f.informers[gvr] = inf
+	}
+	return inf, nil, nil
+}
+
+// handlerCountingInformer counts the event handlers added to it
+type handlerCountingInformer struct {
+	cache.SharedIndexInformer
+	handlers int
+}
+
+func (i *handlerCountingInformer) AddEventHandler(handler cache.ResourceEventHandler) {
+	i.handlers++
+}
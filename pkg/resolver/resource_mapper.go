@@ -0,0 +1,98 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+// DefaultRefreshInterval is the minimum time between refreshes of the cached
+// discovery information, triggered when a kind is not found.
+const DefaultRefreshInterval = 30 * time.Second
+
+// resettableRESTMapper is a RESTMapper whose cached mappings can be discarded.
+type resettableRESTMapper interface {
+	meta.RESTMapper
+	Reset()
+}
+
+// ResourceMapper resolves the resource serving a kind from the discovery
+// information of the cluster. Discovery is cached, and refreshed when a kind
+// is not found so that custom resources installed later are picked up.
+type ResourceMapper struct {
+	mapper          resettableRESTMapper
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	m           sync.Mutex
+	lastRefresh time.Time
+}
+
+// NewResourceMapper creates a ResourceMapper caching the discovery
+// information served by the client.
+func NewResourceMapper(client discovery.DiscoveryInterface) *ResourceMapper {
+	return &ResourceMapper{
+		mapper:          restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client)),
+		refreshInterval: DefaultRefreshInterval,
+		now:             time.Now,
+	}
+}
+
+// ResourceFor returns the resource serving the kind. A KindNotServedError is
+// returned when the cluster does not serve the kind.
+func (m *ResourceMapper) ResourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	mapping, err := m.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) && m.refresh() {
+		mapping, err = m.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if meta.IsNoMatchError(err) {
+		return schema.GroupVersionResource{}, &KindNotServedError{GroupVersionKind: gvk}
+	}
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("failed to map kind %q in %q: %w", gvk.Kind, gvk.GroupVersion(), err)
+	}
+	return mapping.Resource, nil
+}
+
+// refresh discards the cached discovery information, unless it was refreshed
+// within the refresh interval.
+func (m *ResourceMapper) refresh() bool {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	now := m.now()
+	if !m.lastRefresh.IsZero() && now.Sub(m.lastRefresh) < m.refreshInterval {
+		return false
+	}
+	m.lastRefresh = now
+	m.mapper.Reset()
+	return true
+}
+
+// KindNotServedError reports a kind that is not served by the cluster.
+type KindNotServedError struct {
+	GroupVersionKind schema.GroupVersionKind
+}
+
+func (e *KindNotServedError) Error() string {
+	return fmt.Sprintf("no resource is served for kind %q in %q", e.GroupVersionKind.Kind, e.GroupVersionKind.GroupVersion())
+}
+
+// IsKindNotServed returns true when the error, or an error it wraps, is a
+// KindNotServedError.
+func IsKindNotServed(err error) bool {
+	var notServed *KindNotServedError
+	return errors.As(err, &notServed)
+}
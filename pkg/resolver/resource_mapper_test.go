@@ -0,0 +1,129 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+func TestResourceMapper_ResourceFor(t *testing.T) {
+	resources := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "secrets", Namespaced: true, Kind: "Secret"},
+			},
+		},
+		{
+			GroupVersion: "sql.tanzu.vmware.com/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "postgres", Namespaced: true, Kind: "Postgres"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		gvk         schema.GroupVersionKind
+		expected    schema.GroupVersionResource
+		expectedErr string
+	}{
+		{
+			name:     "core kind",
+			gvk:      schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+			expected: schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		},
+		{
+			name:     "irregular plural",
+			gvk:      schema.GroupVersionKind{Group: "sql.tanzu.vmware.com", Version: "v1", Kind: "Postgres"},
+			expected: schema.GroupVersionResource{Group: "sql.tanzu.vmware.com", Version: "v1", Resource: "postgres"},
+		},
+		{
+			name:        "kind not served",
+			gvk:         schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"},
+			expectedErr: `no resource is served for kind "Database" in "example.com/v1"`,
+		},
+		{
+			name:        "version not served",
+			gvk:         schema.GroupVersionKind{Group: "sql.tanzu.vmware.com", Version: "v2", Kind: "Postgres"},
+			expectedErr: `no resource is served for kind "Postgres" in "sql.tanzu.vmware.com/v2"`,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			m := NewResourceMapper(&fakediscovery.FakeDiscovery{Fake: &clientgotesting.Fake{Resources: resources}})
+
+			actual, err := m.ResourceFor(c.gvk)
+			if c.expectedErr != "" {
+				if err == nil || err.Error() != c.expectedErr {
+					t.Errorf("ResourceFor() expected error %q, got %v", c.expectedErr, err)
+				}
+				if !IsKindNotServed(err) {
+					t.Errorf("ResourceFor() expected a KindNotServedError, got %T", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResourceFor() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ResourceFor() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_Refresh(t *testing.T) {
+	core := &metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "secrets", Namespaced: true, Kind: "Secret"},
+		},
+	}
+	fake := &clientgotesting.Fake{Resources: []*metav1.APIResourceList{core}}
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	m := NewResourceMapper(&fakediscovery.FakeDiscovery{Fake: fake})
+	m.now = func() time.Time { return now }
+
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+	expected := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "databases"}
+
+	if _, err := m.ResourceFor(gvk); !IsKindNotServed(err) {
+		t.Fatalf("ResourceFor() expected a KindNotServedError, got %v", err)
+	}
+
+	// the custom resource is installed
+	fake.Resources = []*metav1.APIResourceList{
+		core,
+		{
+			GroupVersion: "example.com/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "databases", Namespaced: true, Kind: "Database"},
+			},
+		},
+	}
+
+	now = now.Add(DefaultRefreshInterval / 2)
+	if _, err := m.ResourceFor(gvk); !IsKindNotServed(err) {
+		t.Errorf("ResourceFor() expected a KindNotServedError within the refresh interval, got %v", err)
+	}
+
+	now = now.Add(DefaultRefreshInterval)
+	actual, err := m.ResourceFor(gvk)
+	if err != nil {
+		t.Fatalf("ResourceFor() unexpected error after the refresh interval: %v", err)
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("ResourceFor() (-expected, +actual): %s", diff)
+	}
+}
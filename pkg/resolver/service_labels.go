@@ -0,0 +1,60 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	labsv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labs/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/metadata"
+	pkgapisduck "knative.dev/pkg/apis/duck"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/tracker"
+)
+
+// NewServiceLabelsFunc returns the labels of a service resource, read from an
+// informer caching the metadata of each kind of service. Services are
+// referenced by name, a reference by selector has no labels to return.
+func NewServiceLabelsFunc(ctx context.Context, client metadata.Interface) labsv1alpha1.ServiceLabelsFunc {
+	mapper := NewResourceMapper(kubeclient.Get(ctx).Discovery())
+	informerFactory := &pkgapisduck.CachedInformerFactory{
+		Delegate: &CountingInformerFactory{
+			Name: "metadata",
+			Delegate: &MetadataInformerFactory{
+				Client:       client,
+				ResyncPeriod: controller.GetResyncPeriod(ctx),
+				StopChannel:  ctx.Done(),
+			},
+		},
+	}
+
+	return func(ctx context.Context, ref tracker.Reference) (map[string]string, error) {
+		if ref.Name == "" {
+			return nil, errors.New("service is not referenced by name")
+		}
+		gvr, err := mapper.ResourceFor(ref.GroupVersionKind())
+		if err != nil {
+			return nil, err
+		}
+		_, lister, err := informerFactory.Get(ctx, gvr)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := lister.ByNamespace(ref.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get service %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		service, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		return service.GetLabels(), nil
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"knative.dev/pkg/tracker"
+)
+
+func TestNewServiceLabelsFunc(t *testing.T) {
+	service := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "bindings.labs.vmware.com/v1alpha1",
+			Kind:       "ProvisionedService",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-service",
+			Labels: map[string]string{
+				"database": "postgres",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		seed        []runtime.Object
+		ref         tracker.Reference
+		expected    map[string]string
+		expectedErr bool
+	}{
+		{
+			name: "labels",
+			seed: []runtime.Object{service},
+			ref: tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: map[string]string{"database": "postgres"},
+		},
+		{
+			name: "not found",
+			ref: tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "kind not served",
+			ref: tracker.Reference{
+				APIVersion: "example.com/v1",
+				Kind:       "Database",
+				Namespace:  "my-namespace",
+				Name:       "my-database",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "not referenced by name",
+			ref: tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+			},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(withClients(context.Background()))
+			defer cancel()
+			scheme := runtime.NewScheme()
+			if err := metav1.AddMetaToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			client := metadatafake.NewSimpleMetadataClient(scheme, c.seed...)
+			serviceLabels := NewServiceLabelsFunc(ctx, client)
+			actual, err := serviceLabels(ctx, c.ref)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("%s: ServiceLabelsFunc() expected err %v, got %v", c.name, c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: ServiceLabelsFunc() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
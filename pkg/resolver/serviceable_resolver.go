@@ -13,49 +13,73 @@ import (
 	duckv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/duck/v1alpha3"
 	"github.com/vmware-tanzu/servicebinding/pkg/client/injection/ducks/duck/v1alpha3/serviceable"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	pkgapisduck "knative.dev/pkg/apis/duck"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
 	"knative.dev/pkg/tracker"
 )
 
 // URIResolver resolves Destinations and ObjectReferences into a URI.
 type ServiceableResolver struct {
-	tracker         tracker.Interface
-	informerFactory pkgapisduck.InformerFactory
+	mapper                      *ResourceMapper
+	tracker                     tracker.Interface
+	informerFactory             pkgapisduck.InformerFactory
+	unstructuredInformerFactory pkgapisduck.InformerFactory
 }
 
 // NewServiceableResolver constructs a ServiceableResolver with context and a callback
 // for a given ServiceableType passed to the ServiceableResolver's tracker.
 func NewServiceableResolver(ctx context.Context, callback func(types.NamespacedName)) *ServiceableResolver {
-	ret := &ServiceableResolver{}
+	ret := &ServiceableResolver{
+		mapper: NewResourceMapper(kubeclient.Get(ctx).Discovery()),
+	}
 
 	ret.tracker = tracker.New(callback, controller.GetTrackerLease(ctx))
 	ret.informerFactory = &pkgapisduck.CachedInformerFactory{
-		Delegate: &pkgapisduck.EnqueueInformerFactory{
-			Delegate:     serviceable.Get(ctx),
-			EventHandler: controller.HandleAll(ret.tracker.OnChanged),
+		Delegate: &CountingInformerFactory{
+			Name: "serviceable",
+			Delegate: &pkgapisduck.EnqueueInformerFactory{
+				Delegate:     serviceable.Get(ctx),
+				EventHandler: controller.HandleAll(ret.tracker.OnChanged),
+			},
+		},
+	}
+	ret.unstructuredInformerFactory = &pkgapisduck.CachedInformerFactory{
+		Delegate: &CountingInformerFactory{
+			Name: "unstructured",
+			Delegate: &pkgapisduck.EnqueueInformerFactory{
+				Delegate: &UnstructuredInformerFactory{
+					Client:       dynamicclient.Get(ctx),
+					ResyncPeriod: controller.GetResyncPeriod(ctx),
+					StopChannel:  ctx.Done(),
+				},
+				EventHandler: controller.HandleAll(ret.tracker.OnChanged),
+			},
 		},
 	}
 	return ret
 }
 
-func (r *ServiceableResolver) ServiceableFromObjectReference(ctx context.Context, ref *tracker.Reference, parent interface{}) (*corev1.LocalObjectReference, error) {
+// ServiceableFromObjectReference resolves the resource holding the binding
+// data of the service, either a Secret or a ConfigMap. Secrets and ConfigMaps
+// are their own binding. A KindNotServedError is returned when the cluster
+// does not serve the kind of the service.
+func (r *ServiceableResolver) ServiceableFromObjectReference(ctx context.Context, ref *tracker.Reference, parent interface{}) (*corev1.TypedLocalObjectReference, error) {
 	if ref == nil {
 		return nil, errors.New("ref is nil")
 	}
-	if ref.APIVersion == "v1" && ref.Kind == "Secret" {
-		return &corev1.LocalObjectReference{Name: ref.Name}, nil
+	if ref.APIVersion == "v1" && (ref.Kind == "Secret" || ref.Kind == "ConfigMap") {
+		return &corev1.TypedLocalObjectReference{Kind: ref.Kind, Name: ref.Name}, nil
 	}
 	if err := r.tracker.TrackReference(*ref, parent); err != nil {
 		return nil, fmt.Errorf("failed to track %+v: %v", ref, err)
 	}
-	gvr, _ := meta.UnsafeGuessKindToResource(ref.GroupVersionKind())
-
-	// Tactical fix for Postgres resource pluralization
-	if gvr.Resource == "postgreses" && gvr.Group == "sql.tanzu.vmware.com" {
-		gvr.Resource = "postgres"
+	gvr, err := r.mapper.ResourceFor(ref.GroupVersionKind())
+	if err != nil {
+		return nil, err
 	}
 	_, lister, err := r.informerFactory.Get(ctx, gvr)
 	if err != nil {
@@ -69,5 +93,39 @@ func (r *ServiceableResolver) ServiceableFromObjectReference(ctx context.Context
 	if !ok {
 		return nil, fmt.Errorf("%+v (%T) is not an ServiceableType", ref, ref)
 	}
-	return &serviceable.Status.Binding, nil
+	kind := serviceable.Status.Binding.Kind
+	switch kind {
+	case "":
+		kind = "Secret"
+	case "Secret", "ConfigMap":
+	default:
+		return nil, fmt.Errorf("%s %q exposes a binding of unsupported kind %q", ref.Kind, ref.Name, kind)
+	}
+	return &corev1.TypedLocalObjectReference{Kind: kind, Name: serviceable.Status.Binding.Name}, nil
+}
+
+// ServiceFromObjectReference resolves the service resource as an
+// *unstructured.Unstructured, so that arbitrary fields can be read from it. A
+// KindNotServedError is returned when the cluster does not serve the kind of
+// the service.
+func (r *ServiceableResolver) ServiceFromObjectReference(ctx context.Context, ref *tracker.Reference, parent interface{}) (*unstructured.Unstructured, error) {
+	if ref == nil {
+		return nil, errors.New("ref is nil")
+	}
+	if err := r.tracker.TrackReference(*ref, parent); err != nil {
+		return nil, fmt.Errorf("failed to track %+v: %v", ref, err)
+	}
+	gvr, err := r.mapper.ResourceFor(ref.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	_, lister, err := r.unstructuredInformerFactory.Get(ctx, gvr)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := lister.ByNamespace(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource for %+v: %v", gvr, err)
+	}
+	return obj.(*unstructured.Unstructured), nil
 }
@@ -15,12 +15,14 @@ import (
 	servicebindingv1alpha3 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1alpha3"
 	"github.com/vmware-tanzu/servicebinding/pkg/client/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/servicebinding/pkg/client/injection/ducks/duck/v1alpha3/serviceable"
+	rtesting "github.com/vmware-tanzu/servicebinding/pkg/reconciler/testing"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	"knative.dev/pkg/tracker"
 )
@@ -33,9 +35,17 @@ func init() {
 	servicebindingv1alpha3.AddToScheme(scheme.Scheme)
 }
 
+// withClients sets up the fake clients, the kube client serving discovery for
+// the kinds of the scheme.
+func withClients(ctx context.Context, seed ...runtime.Object) context.Context {
+	ctx, kubeClient := fakekubeclient.With(ctx)
+	kubeClient.Resources = rtesting.DiscoveryResources(scheme.Scheme)
+	ctx, _ = fakedynamicclient.With(ctx, scheme.Scheme, seed...)
+	return serviceable.WithDuck(ctx)
+}
+
 func TestNewServiceableResolver(t *testing.T) {
-	ctx, _ := fakedynamicclient.With(context.Background(), scheme.Scheme, []runtime.Object{}...)
-	ctx = serviceable.WithDuck(ctx)
+	ctx := withClients(context.Background())
 	r := NewServiceableResolver(ctx, func(types.NamespacedName) {})
 	if r == nil {
 		t.Fatal("expected NewServiceableResolver to return a non-nil value")
@@ -48,7 +58,7 @@ func TestServiceableResolver_ServiceableFromObjectReference(t *testing.T) {
 		seed        []runtime.Object
 		ref         *tracker.Reference
 		parent      interface{}
-		expected    *corev1.LocalObjectReference
+		expected    *corev1.TypedLocalObjectReference
 		expectedErr bool
 	}{
 		{
@@ -86,7 +96,8 @@ func TestServiceableResolver_ServiceableFromObjectReference(t *testing.T) {
 				Namespace:  "my-namespace",
 				Name:       "my-service",
 			},
-			expected: &corev1.LocalObjectReference{
+			expected: &corev1.TypedLocalObjectReference{
+				Kind: "Secret",
 				Name: "my-secret",
 			},
 		},
@@ -105,10 +116,102 @@ func TestServiceableResolver_ServiceableFromObjectReference(t *testing.T) {
 				Namespace:  "my-namespace",
 				Name:       "my-secret",
 			},
-			expected: &corev1.LocalObjectReference{
+			expected: &corev1.TypedLocalObjectReference{
+				Kind: "Secret",
 				Name: "my-secret",
 			},
 		},
+		{
+			name: "lookup serviceable config map",
+			seed: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "bindings.labs.vmware.com/v1alpha1",
+						"kind":       "ProvisionedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"status": map[string]interface{}{
+							"binding": map[string]interface{}{
+								"name": "my-config-map",
+								"kind": "ConfigMap",
+							},
+						},
+					},
+				},
+			},
+			parent: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding",
+				},
+			},
+			ref: &tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expected: &corev1.TypedLocalObjectReference{
+				Kind: "ConfigMap",
+				Name: "my-config-map",
+			},
+		},
+		{
+			name: "lookup serviceable unsupported kind",
+			seed: []runtime.Object{
+				&unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "bindings.labs.vmware.com/v1alpha1",
+						"kind":       "ProvisionedService",
+						"metadata": map[string]interface{}{
+							"namespace": "my-namespace",
+							"name":      "my-service",
+						},
+						"status": map[string]interface{}{
+							"binding": map[string]interface{}{
+								"name": "my-service",
+								"kind": "Service",
+							},
+						},
+					},
+				},
+			},
+			parent: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding",
+				},
+			},
+			ref: &tracker.Reference{
+				APIVersion: "bindings.labs.vmware.com/v1alpha1",
+				Kind:       "ProvisionedService",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "lookup config map",
+			seed: []runtime.Object{},
+			parent: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding",
+				},
+			},
+			ref: &tracker.Reference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Namespace:  "my-namespace",
+				Name:       "my-config-map",
+			},
+			expected: &corev1.TypedLocalObjectReference{
+				Kind: "ConfigMap",
+				Name: "my-config-map",
+			},
+		},
 		{
 			name: "track error",
 			seed: []runtime.Object{
@@ -178,6 +281,23 @@ func TestServiceableResolver_ServiceableFromObjectReference(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "kind not served",
+			seed: []runtime.Object{},
+			parent: &servicebindingv1alpha3.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-namespace",
+					Name:      "my-binding",
+				},
+			},
+			ref: &tracker.Reference{
+				APIVersion: "example.com/v1",
+				Kind:       "Database",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expectedErr: true,
+		},
 		// TODO duckv1alpha1.ServiceableType is always returned, even if the fields are nil
 		// {
 		// 	name: "not serviceable",
@@ -210,8 +330,7 @@ func TestServiceableResolver_ServiceableFromObjectReference(t *testing.T) {
 	}
 	for _, c := range tests {
 		t.Run(c.name, func(t *testing.T) {
-			ctx, _ := fakedynamicclient.With(context.Background(), scheme.Scheme, c.seed...)
-			ctx = serviceable.WithDuck(ctx)
+			ctx := withClients(context.Background(), c.seed...)
 			r := NewServiceableResolver(ctx, func(types.NamespacedName) {})
 
 			actual, err := r.ServiceableFromObjectReference(ctx, c.ref, c.parent)
@@ -228,3 +347,92 @@ func TestServiceableResolver_ServiceableFromObjectReference(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceableResolver_ServiceFromObjectReference(t *testing.T) {
+	service := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "bindings.labs.vmware.com/v1alpha1",
+			"kind":       "ProvisionedService",
+			"metadata": map[string]interface{}{
+				"namespace": "my-namespace",
+				"name":      "my-service",
+			},
+			"status": map[string]interface{}{
+				"host": "db.example.com",
+			},
+		},
+	}
+	binding := &servicebindingv1alpha3.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-namespace",
+			Name:      "my-binding",
+		},
+	}
+	serviceRef := &tracker.Reference{
+		APIVersion: "bindings.labs.vmware.com/v1alpha1",
+		Kind:       "ProvisionedService",
+		Namespace:  "my-namespace",
+		Name:       "my-service",
+	}
+
+	tests := []struct {
+		name        string
+		seed        []runtime.Object
+		ref         *tracker.Reference
+		parent      interface{}
+		expected    *unstructured.Unstructured
+		expectedErr bool
+	}{
+		{
+			name:        "empty",
+			expectedErr: true,
+		},
+		{
+			name:     "lookup service",
+			seed:     []runtime.Object{service.DeepCopy()},
+			parent:   binding,
+			ref:      serviceRef,
+			expected: service,
+		},
+		{
+			name:        "track error",
+			seed:        []runtime.Object{service.DeepCopy()},
+			parent:      nil,
+			ref:         serviceRef,
+			expectedErr: true,
+		},
+		{
+			name:        "lookup error",
+			seed:        []runtime.Object{},
+			parent:      binding,
+			ref:         serviceRef,
+			expectedErr: true,
+		},
+		{
+			name:   "kind not served",
+			seed:   []runtime.Object{},
+			parent: binding,
+			ref: &tracker.Reference{
+				APIVersion: "example.com/v1",
+				Kind:       "Database",
+				Namespace:  "my-namespace",
+				Name:       "my-service",
+			},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := withClients(context.Background(), c.seed...)
+			r := NewServiceableResolver(ctx, func(types.NamespacedName) {})
+
+			actual, err := r.ServiceFromObjectReference(ctx, c.ref, c.parent)
+			if (err != nil) != c.expectedErr {
+				t.Errorf("%s: ServiceFromObjectReference() expected err %v, got %v", c.name, c.expectedErr, err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: ServiceFromObjectReference() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
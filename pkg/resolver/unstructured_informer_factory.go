@@ -0,0 +1,51 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis/duck"
+)
+
+// UnstructuredInformerFactory implements duck.InformerFactory such that the
+// elements tracked by the informer/lister are *unstructured.Unstructured. It
+// is used for resources that are read without a duck type.
+type UnstructuredInformerFactory struct {
+	Client       dynamic.Interface
+	ResyncPeriod time.Duration
+	StopChannel  <-chan struct{}
+}
+
+// Check that UnstructuredInformerFactory implements InformerFactory.
+var _ duck.InformerFactory = (*UnstructuredInformerFactory)(nil)
+
+// Get implements duck.InformerFactory.
+func (f *UnstructuredInformerFactory) Get(ctx context.Context, gvr schema.GroupVersionResource) (cache.SharedIndexInformer, cache.GenericLister, error) {
+	// Avoid error cases, like the GVR does not exist.
+	if _, err := f.Client.Resource(gvr).List(ctx, metav1.ListOptions{}); err != nil {
+		return nil, nil, err
+	}
+
+	inf := dynamicinformer.NewFilteredDynamicInformer(f.Client, gvr, metav1.NamespaceAll, f.ResyncPeriod, cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+	}, nil)
+
+	go inf.Informer().Run(f.StopChannel)
+
+	if ok := cache.WaitForCacheSync(f.StopChannel, inf.Informer().HasSynced); !ok {
+		return nil, nil, fmt.Errorf("failed starting shared index informer for %v", gvr)
+	}
+
+	return inf.Informer(), inf.Lister(), nil
+}
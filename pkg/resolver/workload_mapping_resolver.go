@@ -0,0 +1,49 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	servicebindingv1beta1listers "github.com/vmware-tanzu/servicebinding/pkg/client/listers/servicebinding/v1beta1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkloadMappingResolver resolves the ClusterWorkloadResourceMapping for a
+// workload resource.
+type WorkloadMappingResolver struct {
+	lister servicebindingv1beta1listers.ClusterWorkloadResourceMappingLister
+}
+
+// NewWorkloadMappingResolver constructs a WorkloadMappingResolver backed by the
+// lister.
+func NewWorkloadMappingResolver(lister servicebindingv1beta1listers.ClusterWorkloadResourceMappingLister) *WorkloadMappingResolver {
+	return &WorkloadMappingResolver{
+		lister: lister,
+	}
+}
+
+// TemplateForResource returns the defaulted mapping template for the workload
+// resource. Nil is returned when the resource is not mapped, in which case the
+// resource is expected to be PodSpecable.
+func (r *WorkloadMappingResolver) TemplateForResource(ctx context.Context, gvr schema.GroupVersionResource) (*servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate, error) {
+	mapping, err := r.lister.Get(gvr.GroupResource().String())
+	if apierrs.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	template := mapping.LookupTemplate(gvr.Version)
+	if template == nil {
+		return nil, nil
+	}
+	// don't mutate the informer's copy
+	template = template.DeepCopy()
+	template.SetDefaults(ctx)
+	return template, nil
+}
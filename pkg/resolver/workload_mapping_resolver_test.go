@@ -0,0 +1,100 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	servicebindingv1beta1listers "github.com/vmware-tanzu/servicebinding/pkg/client/listers/servicebinding/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestWorkloadMappingResolver_TemplateForResource(t *testing.T) {
+	cronJobMapping := &servicebindingv1beta1.ClusterWorkloadResourceMapping{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cronjobs.batch",
+		},
+		Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+			Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				{
+					Version:     "v1beta1",
+					Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+					Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+						{
+							Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+							Name: ".name",
+						},
+					},
+					Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		seed     []*servicebindingv1beta1.ClusterWorkloadResourceMapping
+		gvr      schema.GroupVersionResource
+		expected *servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate
+	}{
+		{
+			name:     "not mapped",
+			gvr:      schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+			expected: nil,
+		},
+		{
+			name:     "version not mapped",
+			seed:     []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			gvr:      schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"},
+			expected: nil,
+		},
+		{
+			name: "mapped",
+			seed: []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			gvr:  schema.GroupVersionResource{Group: "batch", Version: "v1beta1", Resource: "cronjobs"},
+			expected: &servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				Version:     "v1beta1",
+				Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+				Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+					{
+						Path:         ".spec.jobTemplate.spec.template.spec.containers[*]",
+						Name:         ".name",
+						Env:          ".env",
+						VolumeMounts: ".volumeMounts",
+					},
+				},
+				Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, m := range c.seed {
+				indexer.Add(m)
+			}
+			r := NewWorkloadMappingResolver(servicebindingv1beta1listers.NewClusterWorkloadResourceMappingLister(indexer))
+
+			actual, err := r.TemplateForResource(context.TODO(), c.gvr)
+			if err != nil {
+				t.Fatalf("TemplateForResource() unexpected err %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: TemplateForResource() (-expected, +actual): %s", c.name, diff)
+			}
+			for _, m := range c.seed {
+				if len(m.Spec.Versions[0].Containers[0].Env) != 0 {
+					t.Errorf("%s: TemplateForResource() mutated the lister's copy", c.name)
+				}
+			}
+		})
+	}
+}
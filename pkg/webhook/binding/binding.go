@@ -0,0 +1,391 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis/duck"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	nsinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/webhook"
+	"knative.dev/pkg/webhook/psbinding"
+
+	clusterworkloadresourcemappinginformer "github.com/vmware-tanzu/servicebinding/pkg/client/injection/informers/servicebinding/v1beta1/clusterworkloadresourcemapping"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+	"github.com/vmware-tanzu/servicebinding/pkg/metrics"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
+)
+
+// NewAdmissionController constructs the webhook portion of the pair of
+// reconcilers that implement the semantics of our Binding. It extends the
+// psbinding admission controller to bind workload resources that are
+// described by a ClusterWorkloadResourceMapping and Pods when they are created,
+// and only binds the workloads selected by the binding ConfigMap and the labels
+// of their namespace.
+func NewAdmissionController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+	name, path string,
+	gla psbinding.GetListAll,
+	withContext psbinding.BindableContext,
+	reconcilerOptions ...psbinding.ReconcilerOption,
+) *controller.Impl {
+	clusterWorkloadResourceMappingInformer := clusterworkloadresourcemappinginformer.Get(ctx)
+	mapper := resolver.NewResourceMapper(kubeclient.Get(ctx).Discovery())
+
+	c := psbinding.NewAdmissionController(ctx, name, path, instrumentGetListAll(gla, mapper), withContext, reconcilerOptions...)
+
+	// Reconcile the webhook selectors when the selection mode changes.
+	configStore := config.NewStore(logging.FromContext(ctx).Named("config-store"), func(name string, value interface{}) {
+		if name == config.BindingConfigName {
+			c.EnqueueKey(sentinel)
+		}
+	})
+	configStore.WatchConfigs(cmw)
+
+	c.Reconciler = &Reconciler{
+		Reconciler:      c.Reconciler.(*psbinding.Reconciler),
+		mappingResolver: resolver.NewWorkloadMappingResolver(clusterWorkloadResourceMappingInformer.Lister()),
+		configStore:     configStore,
+		nsLister:        nsinformer.Get(ctx).Lister(),
+	}
+	return c
+}
+
+// sentinel is the key psbinding reconciles its webhook for.
+var sentinel = types.NamespacedName{}
+
+// Reconciler wraps the psbinding.Reconciler, intercepting admission requests
+// for mapped workload resources and Pods. Requests for resources that are not
+// mapped are handled as PodSpecable resources. The webhook selectors are
+// reconciled from the selection mode of the binding ConfigMap.
+type Reconciler struct {
+	*psbinding.Reconciler
+
+	mappingResolver *resolver.WorkloadMappingResolver
+	configStore     *config.Store
+	nsLister        corev1listers.NamespaceLister
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+var _ webhook.AdmissionController = (*Reconciler)(nil)
+
+// Reconcile implements controller.Reconciler
+func (ac *Reconciler) Reconcile(ctx context.Context, key string) error {
+	if err := ac.Reconciler.Reconcile(ctx, key); err != nil {
+		return err
+	}
+	// Only the leader should be mutating the webhook.
+	if !ac.IsLeaderFor(sentinel) {
+		return nil
+	}
+	return ac.reconcileWebhookSelectors(ctx)
+}
+
+// Admit implements AdmissionController
+func (ac *Reconciler) Admit(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	response := ac.admit(ctx, request)
+	if !response.Allowed {
+		metrics.RecordAdmissionFailure(ctx, string(request.Operation), schema.GroupVersionResource{
+			Group:    request.Resource.Group,
+			Version:  request.Resource.Version,
+			Resource: request.Resource.Resource,
+		})
+	}
+	return response
+}
+
+func (ac *Reconciler) admit(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if IsPod(schema.GroupResource{Group: request.Resource.Group, Resource: request.Resource.Resource}) {
+		return ac.admitPod(ctx, request)
+	}
+
+	switch request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+	default:
+		return ac.Reconciler.Admit(ctx, request)
+	}
+
+	selected, err := ac.selects(ctx, request)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to select object: %v", err)
+	}
+	if !selected {
+		// The workload or its namespace is not opted in to being bound.
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	gvr := schema.GroupVersionResource{
+		Group:    request.Resource.Group,
+		Version:  request.Resource.Version,
+		Resource: request.Resource.Resource,
+	}
+	template, err := ac.mappingResolver.TemplateForResource(ctx, gvr)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to resolve mapping for %s: %v", gvr, err)
+	}
+	if template == nil {
+		// not mapped, treat as PodSpecable
+		return ac.Reconciler.Admit(ctx, request)
+	}
+
+	orig := &unstructured.Unstructured{}
+	if err := orig.UnmarshalJSON(request.Object.Raw); err != nil {
+		return webhook.MakeErrorStatus("unable to decode object: %v", err)
+	}
+
+	// Look up the Bindables for this resource.
+	fbs, err := ac.lookUp(request.Kind.Group, request.Kind.Kind, request.Namespace, orig)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to list bindings: %v", err)
+	}
+	if len(fbs) == 0 {
+		// This doesn't apply!
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	ps, err := template.ExtractPodSpecable(orig)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to map object: %v", err)
+	}
+
+	// Apply the Bindables to the mapped subject state.
+	if err := ac.bind(ctx, fbs, ps); err != nil {
+		return webhook.MakeErrorStatus("unable to setup binding context: %v", err)
+	}
+
+	mutated := orig.DeepCopy()
+	if err := template.InjectPodSpecable(mutated, ps); err != nil {
+		return webhook.MakeErrorStatus("unable to map object: %v", err)
+	}
+
+	// Synthesize a patch from the changes and return it in our AdmissionResponse
+	patchBytes, err := duck.CreateBytePatch(orig, mutated)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to create patch with binding: %v", err)
+	}
+	logging.FromContext(ctx).Debugf("Binding mapped %s %s/%s", gvr, request.Namespace, orig.GetName())
+	return patchResponse(patchBytes)
+}
+
+// admitPod binds a Pod when it is created. A Pod's spec is immutable, updates
+// to the Pod and its subresources are admitted as is.
+func (ac *Reconciler) admitPod(ctx context.Context, request *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if request.Operation != admissionv1.Create || request.SubResource != "" {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	selected, err := ac.selects(ctx, request)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to select object: %v", err)
+	}
+	if !selected {
+		// The pod or its namespace is not opted in to being bound.
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	orig := &unstructured.Unstructured{}
+	if err := orig.UnmarshalJSON(request.Object.Raw); err != nil {
+		return webhook.MakeErrorStatus("unable to decode object: %v", err)
+	}
+
+	// Look up the Bindables for this pod.
+	fbs, err := ac.lookUp(corev1.GroupName, "Pod", request.Namespace, orig)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to list bindings: %v", err)
+	}
+	if len(fbs) == 0 {
+		// This doesn't apply!
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(request.Object.Raw, pod); err != nil {
+		return webhook.MakeErrorStatus("unable to decode object: %v", err)
+	}
+	ps := ExtractPod(pod)
+	if err := ac.bind(ctx, fbs, ps); err != nil {
+		return webhook.MakeErrorStatus("unable to setup binding context: %v", err)
+	}
+	mutated := pod.DeepCopy()
+	InjectPod(mutated, ps)
+
+	// Synthesize a patch from the changes and return it in our AdmissionResponse
+	patchBytes, err := duck.CreateBytePatch(pod, mutated)
+	if err != nil {
+		return webhook.MakeErrorStatus("unable to create patch with binding: %v", err)
+	}
+	logging.FromContext(ctx).Debugf("Binding pod %s/%s", request.Namespace, orig.GetName())
+	return patchResponse(patchBytes)
+}
+
+// bind applies the Bindables to the subject state, according to the deletion
+// state of each Bindable.
+func (ac *Reconciler) bind(ctx context.Context, fbs []psbinding.Bindable, ps *duckv1.WithPod) error {
+	for _, fb := range fbs {
+		bindingContext := ctx
+		// Callback into the user's code to setup the context with additional
+		// information needed to perform the mutation.
+		if ac.WithContext != nil {
+			var err error
+			bindingContext, err = ac.WithContext(ctx, fb)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Mutate the subject state according to the deletion state of the Bindable.
+		if fb.GetDeletionTimestamp() != nil {
+			fb.Undo(bindingContext, ps)
+		} else {
+			fb.Do(bindingContext, ps)
+		}
+	}
+	return nil
+}
+
+func patchResponse(patchBytes []byte) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Patch:   patchBytes,
+		Allowed: true,
+		PatchType: func() *admissionv1.PatchType {
+			pt := admissionv1.PatchTypeJSONPatch
+			return &pt
+		}(),
+	}
+}
+
+// selects decides whether the object of the request is bound, according to the
+// current selection mode and the labels of the object and its namespace. The
+// webhook selectors already keep other objects away, this is a backstop for
+// requests admitted before the selectors caught up with the ConfigMap. Legacy
+// labels of the object and its namespace are counted, so their removal can be
+// scheduled.
+func (ac *Reconciler) selects(ctx context.Context, request *admissionv1.AdmissionRequest) (bool, error) {
+	obj := &metav1.PartialObjectMetadata{}
+	if err := json.Unmarshal(request.Object.Raw, obj); err != nil {
+		return false, fmt.Errorf("unable to decode object: %w", err)
+	}
+	ns, err := ac.nsLister.Get(request.Namespace)
+	if apierrs.IsNotFound(err) {
+		ns = nil
+	} else if err != nil {
+		return false, err
+	}
+
+	for _, label := range config.LegacyLabels(obj.Labels) {
+		metrics.RecordLegacyLabel(ctx, label, schema.GroupResource{
+			Group:    request.Resource.Group,
+			Resource: request.Resource.Resource,
+		})
+	}
+	if ns != nil {
+		for _, label := range config.LegacyLabels(ns.Labels) {
+			metrics.RecordLegacyLabel(ctx, label, corev1.Resource("namespaces"))
+		}
+	}
+
+	return ac.configStore.Load().Binding.Selects(ns, obj.Labels), nil
+}
+
+// lookUp finds the Bindables whose subject matches the object either by name
+// or by label selector.
+func (ac *Reconciler) lookUp(group, kind, namespace string, obj *unstructured.Unstructured) ([]psbinding.Bindable, error) {
+	all, err := ac.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	fbs := []psbinding.Bindable{}
+	for _, fb := range all {
+		ref := fb.GetSubject()
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		if gv.Group != group || ref.Kind != kind || ref.Namespace != namespace {
+			continue
+		}
+		if ref.Name != "" {
+			if ref.Name == obj.GetName() {
+				fbs = append(fbs, fb)
+			}
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+		if err != nil {
+			return nil, err
+		}
+		if selector.Matches(labels.Set(obj.GetLabels())) {
+			fbs = append(fbs, fb)
+		}
+	}
+	return fbs, nil
+}
+
+// instrumentGetListAll wraps the Bindables listed for the webhook so that the
+// latency of their mutations is recorded, by the resource of their subject.
+func instrumentGetListAll(gla psbinding.GetListAll, mapper *resolver.ResourceMapper) psbinding.GetListAll {
+	return func(ctx context.Context, handler cache.ResourceEventHandler) psbinding.ListAll {
+		listAll := gla(ctx, handler)
+		return func() ([]psbinding.Bindable, error) {
+			fbs, err := listAll()
+			if err != nil {
+				return nil, err
+			}
+			for i := range fbs {
+				fbs[i] = &instrumentedBindable{Bindable: fbs[i], mapper: mapper}
+			}
+			return fbs, nil
+		}
+	}
+}
+
+// instrumentedBindable records the latency of the Do and Undo mutations of
+// the Bindable.
+type instrumentedBindable struct {
+	psbinding.Bindable
+	mapper *resolver.ResourceMapper
+}
+
+func (b *instrumentedBindable) Do(ctx context.Context, ps *duckv1.WithPod) {
+	defer b.record(ctx, metrics.OperationDo, time.Now())
+	b.Bindable.Do(ctx, ps)
+}
+
+func (b *instrumentedBindable) Undo(ctx context.Context, ps *duckv1.WithPod) {
+	defer b.record(ctx, metrics.OperationUndo, time.Now())
+	b.Bindable.Undo(ctx, ps)
+}
+
+func (b *instrumentedBindable) record(ctx context.Context, operation string, start time.Time) {
+	subject := b.GetSubject()
+	d := time.Since(start)
+	gvr, err := b.mapper.ResourceFor(schema.FromAPIVersionAndKind(subject.APIVersion, subject.Kind))
+	if err != nil {
+		// the subject was admitted, its kind is served unless it was removed
+		// since
+		return
+	}
+	metrics.RecordProjectionMutation(ctx, operation, gvr.GroupResource(), d)
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opencensus.io/stats/view"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	logtesting "knative.dev/pkg/logging/testing"
+	_ "knative.dev/pkg/metrics/testing"
+	"knative.dev/pkg/tracker"
+	"knative.dev/pkg/webhook/psbinding"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	servicebindingv1beta1listers "github.com/vmware-tanzu/servicebinding/pkg/client/listers/servicebinding/v1beta1"
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
+)
+
+func TestAdmit(t *testing.T) {
+	testNamespace := "test-namespace"
+
+	cronJobMapping := &servicebindingv1beta1.ClusterWorkloadResourceMapping{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cronjobs.batch",
+		},
+		Spec: servicebindingv1beta1.ClusterWorkloadResourceMappingSpec{
+			Versions: []servicebindingv1beta1.ClusterWorkloadResourceMappingTemplate{
+				{
+					Version:     "v1beta1",
+					Annotations: ".spec.jobTemplate.spec.template.metadata.annotations",
+					Containers: []servicebindingv1beta1.ClusterWorkloadResourceMappingContainer{
+						{
+							Path: ".spec.jobTemplate.spec.template.spec.containers[*]",
+							Name: ".name",
+						},
+					},
+					Volumes: ".spec.jobTemplate.spec.template.spec.volumes",
+				},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "my-pod",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app", Image: "my-app"},
+			},
+		},
+	}
+	cronJob := &batchv1beta1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1beta1",
+			Kind:       "CronJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "my-cronjob",
+		},
+		Spec: batchv1beta1.CronJobSpec{
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: "app", Image: "my-app"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "my-deployment",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "app", Image: "my-app"},
+					},
+				},
+			},
+		},
+	}
+
+	podGVR := metav1.GroupVersionResource{Version: "v1", Resource: "pods"}
+	podGVK := metav1.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	cronJobGVR := metav1.GroupVersionResource{Group: "batch", Version: "v1beta1", Resource: "cronjobs"}
+	cronJobGVK := metav1.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+	deploymentGVR := metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deploymentGVK := metav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+	podPatch := `[{"op":"add","path":"/spec/containers/0/env","value":[{"name":"SERVICE_BINDING_ROOT","value":"/bindings"}]}]`
+	cronJobPatch := `[{"op":"add","path":"/spec/jobTemplate/spec/template/spec/containers/0/env","value":[{"name":"SERVICE_BINDING_ROOT","value":"/bindings"}]}]`
+
+	tests := []struct {
+		name          string
+		selectionMode string
+		namespace     *corev1.Namespace
+		mappings      []*servicebindingv1beta1.ClusterWorkloadResourceMapping
+		bindables     []psbinding.Bindable
+		request       *admissionv1.AdmissionRequest
+		expectedPatch string
+		// expectedLegacyLabels holds the legacy labels recorded, as
+		// label/group/resource
+		expectedLegacyLabels []string
+	}{
+		{
+			name:          "bind pod on create",
+			bindables:     []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request:       admissionRequest(admissionv1.Create, podGVK, podGVR, "", pod),
+			expectedPatch: podPatch,
+		},
+		{
+			name:      "ignore pod on update",
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request:   admissionRequest(admissionv1.Update, podGVK, podGVR, "", pod),
+		},
+		{
+			name:      "ignore pod subresource",
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request:   admissionRequest(admissionv1.Create, podGVK, podGVR, "status", pod),
+		},
+		{
+			name:      "ignore pod not bound",
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "other-pod")},
+			request:   admissionRequest(admissionv1.Create, podGVK, podGVR, "", pod),
+		},
+		{
+			name:          "bind mapped workload",
+			mappings:      []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			bindables:     []psbinding.Bindable{testBindable(testNamespace, "batch/v1beta1", "CronJob", "my-cronjob")},
+			request:       admissionRequest(admissionv1.Update, cronJobGVK, cronJobGVR, "", cronJob),
+			expectedPatch: cronJobPatch,
+		},
+		{
+			name:      "ignore mapped workload on delete",
+			mappings:  []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "batch/v1beta1", "CronJob", "my-cronjob")},
+			request:   admissionRequest(admissionv1.Delete, cronJobGVK, cronJobGVR, "", cronJob),
+		},
+		{
+			// the psbinding index is only built when the webhook
+			// configuration is reconciled, the bindable is not found
+			name:      "fall back to psbinding for unmapped workload",
+			mappings:  []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "apps/v1", "Deployment", "my-deployment")},
+			request:   admissionRequest(admissionv1.Create, deploymentGVK, deploymentGVR, "", deployment),
+		},
+		{
+			name:          "inclusion mode ignores workload not included",
+			selectionMode: config.SelectionModeInclusion,
+			bindables:     []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request:       admissionRequest(admissionv1.Create, podGVK, podGVR, "", pod),
+		},
+		{
+			name:          "inclusion mode binds workload included",
+			selectionMode: config.SelectionModeInclusion,
+			bindables:     []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request: admissionRequest(admissionv1.Create, podGVK, podGVR, "", withLabels(pod, map[string]string{
+				config.BindingIncludeLabel: "true",
+			})),
+			expectedPatch: podPatch,
+		},
+		{
+			name:          "inclusion mode binds workload in namespace included",
+			selectionMode: config.SelectionModeInclusion,
+			namespace:     testNamespaceWithLabels(testNamespace, map[string]string{config.BindingIncludeLabel: "true"}),
+			bindables:     []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request:       admissionRequest(admissionv1.Create, podGVK, podGVR, "", pod),
+			expectedPatch: podPatch,
+		},
+		{
+			name:      "ignore workload excluded",
+			mappings:  []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "batch/v1beta1", "CronJob", "my-cronjob")},
+			request: admissionRequest(admissionv1.Update, cronJobGVK, cronJobGVR, "", withLabels(cronJob, map[string]string{
+				config.BindingExcludeLabel: "true",
+			})),
+		},
+		{
+			name:      "ignore workload in namespace excluded",
+			namespace: testNamespaceWithLabels(testNamespace, map[string]string{config.BindingExcludeLabel: "true"}),
+			mappings:  []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "batch/v1beta1", "CronJob", "my-cronjob")},
+			request:   admissionRequest(admissionv1.Update, cronJobGVK, cronJobGVR, "", cronJob),
+		},
+		{
+			name:      "ignore workload with legacy exclude label",
+			bindables: []psbinding.Bindable{testBindable(testNamespace, "v1", "Pod", "my-pod")},
+			request: admissionRequest(admissionv1.Create, podGVK, podGVR, "", withLabels(pod, map[string]string{
+				config.LegacyBindingExcludeLabel: "true",
+			})),
+			expectedLegacyLabels: []string{
+				fmt.Sprintf("%s//pods", config.LegacyBindingExcludeLabel),
+			},
+		},
+		{
+			name:          "bind workload in namespace with legacy include label",
+			selectionMode: config.SelectionModeInclusion,
+			namespace:     testNamespaceWithLabels(testNamespace, map[string]string{config.LegacyBindingIncludeLabel: "true"}),
+			mappings:      []*servicebindingv1beta1.ClusterWorkloadResourceMapping{cronJobMapping},
+			bindables:     []psbinding.Bindable{testBindable(testNamespace, "batch/v1beta1", "CronJob", "my-cronjob")},
+			request:       admissionRequest(admissionv1.Update, cronJobGVK, cronJobGVR, "", cronJob),
+			expectedPatch: cronJobPatch,
+			expectedLegacyLabels: []string{
+				fmt.Sprintf("%s//namespaces", config.LegacyBindingIncludeLabel),
+			},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			resetView(t, "binding_legacy_label_count")
+
+			ctx := context.TODO()
+
+			configStore := config.NewStore(logtesting.TestLogger(t))
+			bindingConfig := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: config.BindingConfigName},
+				Data:       map[string]string{},
+			}
+			if c.selectionMode != "" {
+				bindingConfig.Data["selection-mode"] = c.selectionMode
+			}
+			configStore.OnConfigChanged(bindingConfig)
+			mappingIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, m := range c.mappings {
+				mappingIndexer.Add(m)
+			}
+			nsIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			if c.namespace != nil {
+				nsIndexer.Add(c.namespace)
+			}
+
+			ac := &Reconciler{
+				Reconciler: &psbinding.Reconciler{
+					ListAll: func() ([]psbinding.Bindable, error) {
+						return c.bindables, nil
+					},
+				},
+				mappingResolver: resolver.NewWorkloadMappingResolver(servicebindingv1beta1listers.NewClusterWorkloadResourceMappingLister(mappingIndexer)),
+				configStore:     configStore,
+				nsLister:        corev1listers.NewNamespaceLister(nsIndexer),
+			}
+
+			response := ac.Admit(ctx, c.request)
+			if !response.Allowed {
+				t.Fatalf("Admit() expected to be allowed, got %v", response.Result)
+			}
+			if diff := cmp.Diff(c.expectedPatch, string(response.Patch)); diff != "" {
+				t.Errorf("Admit() patch (-expected, +actual): %s", diff)
+			}
+
+			rows, err := view.RetrieveData("binding_legacy_label_count")
+			if err != nil {
+				t.Fatalf("RetrieveData() unexpected err %v", err)
+			}
+			actualLegacyLabels := []string{}
+			for _, row := range rows {
+				tags := map[string]string{}
+				for _, tag := range row.Tags {
+					tags[tag.Key.Name()] = tag.Value
+				}
+				actualLegacyLabels = append(actualLegacyLabels, fmt.Sprintf("%s/%s/%s", tags["label"], tags["resource_group"], tags["resource_resource"]))
+			}
+			sort.Strings(actualLegacyLabels)
+			if c.expectedLegacyLabels == nil {
+				c.expectedLegacyLabels = []string{}
+			}
+			if diff := cmp.Diff(c.expectedLegacyLabels, actualLegacyLabels); diff != "" {
+				t.Errorf("Admit() legacy labels (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+// testBindableProjection binds its subject by setting SERVICE_BINDING_ROOT on
+// each container, keeping the expected patches short.
+type testBindableProjection struct {
+	*labsinternalv1alpha1.ServiceBindingProjection
+}
+
+func testBindable(namespace, apiVersion, kind, name string) psbinding.Bindable {
+	return &testBindableProjection{
+		ServiceBindingProjection: &labsinternalv1alpha1.ServiceBindingProjection{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "my-binding",
+			},
+			Spec: labsinternalv1alpha1.ServiceBindingProjectionSpec{
+				Workload: labsinternalv1alpha1.WorkloadReference{
+					Reference: tracker.Reference{
+						APIVersion: apiVersion,
+						Kind:       kind,
+						Name:       name,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *testBindableProjection) Do(ctx context.Context, ps *duckv1.WithPod) {
+	for i := range ps.Spec.Template.Spec.Containers {
+		c := &ps.Spec.Template.Spec.Containers[i]
+		c.Env = append(c.Env, corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"})
+	}
+}
+
+func (b *testBindableProjection) Undo(ctx context.Context, ps *duckv1.WithPod) {}
+
+func admissionRequest(operation admissionv1.Operation, gvk metav1.GroupVersionKind, gvr metav1.GroupVersionResource, subResource string, obj metav1.Object) *admissionv1.AdmissionRequest {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	return &admissionv1.AdmissionRequest{
+		Operation:   operation,
+		Kind:        gvk,
+		Resource:    gvr,
+		SubResource: subResource,
+		Namespace:   obj.GetNamespace(),
+		Name:        obj.GetName(),
+		Object:      runtime.RawExtension{Raw: raw},
+	}
+}
+
+func withLabels(obj metav1.Object, labels map[string]string) metav1.Object {
+	obj = obj.(runtime.Object).DeepCopyObject().(metav1.Object)
+	obj.SetLabels(labels)
+	return obj
+}
+
+func testNamespaceWithLabels(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+// resetView clears the data recorded for the view.
+func resetView(t *testing.T, name string) {
+	v := view.Find(name)
+	view.Unregister(v)
+	if err := view.Register(v); err != nil {
+		t.Fatalf("Register() unexpected err %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// IsPod returns true when the workload resource is a core Pod. A Pod's spec is
+// immutable, a Pod is only bound when it is created.
+func IsPod(gr schema.GroupResource) bool {
+	return gr == corev1.Resource("pods")
+}
+
+// ExtractPod builds a PodSpecable view of the Pod. A Pod is its own template,
+// the annotations of the PodSpecable and of its template are the same map,
+// holding a copy of the Pod's annotations.
+func ExtractPod(pod *corev1.Pod) *duckv1.WithPod {
+	annotations := make(map[string]string, len(pod.Annotations))
+	for k, v := range pod.Annotations {
+		annotations[k] = v
+	}
+	ps := &duckv1.WithPod{}
+	ps.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	pod.ObjectMeta.DeepCopyInto(&ps.ObjectMeta)
+	ps.Annotations = annotations
+	ps.Spec.Template.Annotations = annotations
+	pod.Spec.DeepCopyInto(&ps.Spec.Template.Spec)
+	return ps
+}
+
+// InjectPod writes the spec and the template annotations of a PodSpecable
+// extracted by ExtractPod back into the Pod.
+func InjectPod(pod *corev1.Pod, ps *duckv1.WithPod) {
+	pod.Annotations = nil
+	if len(ps.Spec.Template.Annotations) != 0 {
+		pod.Annotations = make(map[string]string, len(ps.Spec.Template.Annotations))
+		for k, v := range ps.Spec.Template.Annotations {
+			pod.Annotations[k] = v
+		}
+	}
+	ps.Spec.Template.Spec.DeepCopyInto(&pod.Spec)
+}
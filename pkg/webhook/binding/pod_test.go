@@ -0,0 +1,209 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestIsPod(t *testing.T) {
+	if !IsPod(corev1.Resource("pods")) {
+		t.Errorf("IsPod() expected true for pods")
+	}
+	if IsPod(appsv1.Resource("deployments")) {
+		t.Errorf("IsPod() expected false for deployments")
+	}
+}
+
+func TestExtractPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "my-namespace",
+			Name:        "my-pod",
+			Labels:      map[string]string{"app": "my-app"},
+			Annotations: map[string]string{"my-annotation": "my-value"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app", Image: "my-app"},
+			},
+		},
+	}
+	expected := &duckv1.WithPod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "my-namespace",
+			Name:        "my-pod",
+			Labels:      map[string]string{"app": "my-app"},
+			Annotations: map[string]string{"my-annotation": "my-value"},
+		},
+		Spec: duckv1.WithPodSpec{
+			Template: duckv1.PodSpecable{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"my-annotation": "my-value"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "app", Image: "my-app"},
+					},
+				},
+			},
+		},
+	}
+	actual := ExtractPod(pod)
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("ExtractPod() (-expected, +actual): %s", diff)
+	}
+
+	// the annotations of the PodSpecable are those of its template
+	actual.Annotations["other-annotation"] = "other-value"
+	if actual.Spec.Template.Annotations["other-annotation"] != "other-value" {
+		t.Errorf("ExtractPod() annotations differ from the template annotations")
+	}
+
+	// the PodSpecable must not alias the pod
+	actual.Spec.Template.Spec.Containers[0].Image = "other-app"
+	if _, ok := pod.Annotations["other-annotation"]; ok {
+		t.Errorf("ExtractPod() annotations alias the pod")
+	}
+	if pod.Spec.Containers[0].Image != "my-app" {
+		t.Errorf("ExtractPod() spec aliases the pod")
+	}
+}
+
+func TestInjectPod(t *testing.T) {
+	seed := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-pod",
+				Annotations: map[string]string{
+					"my-annotation":   "my-value",
+					"my-binding":      "my-secret",
+					"my-binding-type": "mysql",
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "app", Image: "my-app"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		seed     *corev1.Pod
+		mutation func(ps *duckv1.WithPod)
+		expected *corev1.Pod
+	}{
+		{
+			name:     "no changes",
+			seed:     seed(),
+			mutation: func(ps *duckv1.WithPod) {},
+			expected: seed(),
+		},
+		{
+			name: "inject",
+			seed: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "app", Image: "my-app"},
+					},
+				},
+			},
+			mutation: func(ps *duckv1.WithPod) {
+				ps.Annotations["my-binding"] = "my-secret"
+				ps.Spec.Template.Annotations["my-binding-type"] = "mysql"
+				c := &ps.Spec.Template.Spec.Containers[0]
+				c.Env = append(c.Env, corev1.EnvVar{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"})
+				c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: "my-volume", MountPath: "/bindings/my-binding"})
+				ps.Spec.Template.Spec.Volumes = append(ps.Spec.Template.Spec.Volumes, corev1.Volume{
+					Name: "my-volume",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: "my-secret"},
+					},
+				})
+			},
+			expected: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"my-binding":      "my-secret",
+						"my-binding-type": "mysql",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "app",
+							Image: "my-app",
+							Env: []corev1.EnvVar{
+								{Name: "SERVICE_BINDING_ROOT", Value: "/bindings"},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "my-volume", MountPath: "/bindings/my-binding"},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "my-volume",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: "my-secret"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "remove annotations",
+			seed: seed(),
+			mutation: func(ps *duckv1.WithPod) {
+				delete(ps.Annotations, "my-binding")
+				delete(ps.Spec.Template.Annotations, "my-binding-type")
+			},
+			expected: func() *corev1.Pod {
+				pod := seed()
+				delete(pod.Annotations, "my-binding")
+				delete(pod.Annotations, "my-binding-type")
+				return pod
+			}(),
+		},
+		{
+			name: "remove all annotations",
+			seed: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"my-binding": "my-secret"},
+				},
+			},
+			mutation: func(ps *duckv1.WithPod) {
+				delete(ps.Spec.Template.Annotations, "my-binding")
+			},
+			expected: &corev1.Pod{},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.seed.DeepCopy()
+			ps := ExtractPod(actual)
+			c.mutation(ps)
+			InjectPod(actual, ps)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("%s: InjectPod() (-expected, +actual): %s", c.name, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/logging"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+)
+
+// webhookSelector selects the namespaces and objects sent to one webhook of
+// the MutatingWebhookConfiguration.
+type webhookSelector struct {
+	// prefix is prepended to the name of the webhook reconciled by psbinding
+	// to name this webhook. The webhook reconciled by psbinding has no prefix.
+	prefix    string
+	namespace []metav1.LabelSelectorRequirement
+	object    []metav1.LabelSelectorRequirement
+}
+
+// webhookSelectors returns the webhooks selecting the workloads bound in the
+// selection mode, so that the API server only calls the binding webhook for
+// them. Label selectors can't express that either the namespace or the
+// workload is included, so in the inclusion mode each case is a webhook of
+// its own, and the webhooks never select the same workload twice.
+//
+// The first webhook is the one reconciled by psbinding, which uses the same
+// selector for namespaces and objects, and manages the expressions on the
+// legacy knative.dev labels of its namespace selector.
+func webhookSelectors(mode string) []webhookSelector {
+	if mode != config.SelectionModeInclusion {
+		return []webhookSelector{{
+			namespace: []metav1.LabelSelectorRequirement{notLabeled(config.BindingExcludeLabel)},
+			object:    []metav1.LabelSelectorRequirement{notLabeled(config.BindingExcludeLabel)},
+		}}
+	}
+
+	notExcluded := func(requirements ...metav1.LabelSelectorRequirement) []metav1.LabelSelectorRequirement {
+		return append([]metav1.LabelSelectorRequirement{
+			notLabeled(config.BindingExcludeLabel),
+			notLabeled(config.LegacyBindingExcludeLabel),
+		}, requirements...)
+	}
+	return []webhookSelector{
+		{
+			// both the namespace and the workload are included
+			namespace: []metav1.LabelSelectorRequirement{notLabeled(config.BindingExcludeLabel), labeled(config.BindingIncludeLabel)},
+			object:    []metav1.LabelSelectorRequirement{notLabeled(config.BindingExcludeLabel), labeled(config.BindingIncludeLabel)},
+		},
+		{
+			prefix:    "namespace",
+			namespace: notExcluded(labeled(config.BindingIncludeLabel)),
+			object:    notExcluded(notLabeled(config.BindingIncludeLabel)),
+		},
+		{
+			prefix:    "legacy-namespace",
+			namespace: notExcluded(notLabeled(config.BindingIncludeLabel), labeled(config.LegacyBindingIncludeLabel)),
+			object:    notExcluded(),
+		},
+		{
+			prefix:    "workload",
+			namespace: notExcluded(notLabeled(config.BindingIncludeLabel), notLabeled(config.LegacyBindingIncludeLabel)),
+			object:    notExcluded(labeled(config.BindingIncludeLabel)),
+		},
+		{
+			prefix:    "legacy-workload",
+			namespace: notExcluded(notLabeled(config.BindingIncludeLabel), notLabeled(config.LegacyBindingIncludeLabel)),
+			object:    notExcluded(notLabeled(config.BindingIncludeLabel), labeled(config.LegacyBindingIncludeLabel)),
+		},
+	}
+}
+
+func labeled(label string) metav1.LabelSelectorRequirement {
+	return metav1.LabelSelectorRequirement{
+		Key:      label,
+		Operator: metav1.LabelSelectorOpIn,
+		Values:   []string{"true"},
+	}
+}
+
+func notLabeled(label string) metav1.LabelSelectorRequirement {
+	return metav1.LabelSelectorRequirement{
+		Key:      label,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{"true"},
+	}
+}
+
+// reconcileWebhookSelectors updates the selectors of the
+// MutatingWebhookConfiguration for the current selection mode, once psbinding
+// reconciled its webhook. The other webhooks are copies of it, with their own
+// selectors.
+func (ac *Reconciler) reconcileWebhookSelectors(ctx context.Context) error {
+	configuredWebhook, err := ac.MWHLister.Get(ac.Name)
+	if err != nil {
+		return fmt.Errorf("error retrieving webhook: %w", err)
+	}
+	current := configuredWebhook.DeepCopy()
+
+	var reconciled *admissionregistrationv1.MutatingWebhook
+	for i := range current.Webhooks {
+		if current.Webhooks[i].Name == current.Name {
+			reconciled = &current.Webhooks[i]
+		}
+	}
+	if reconciled == nil {
+		return fmt.Errorf("missing webhook: %s", current.Name)
+	}
+
+	selectors := webhookSelectors(ac.configStore.Load().Binding.SelectionMode)
+	webhooks := make([]admissionregistrationv1.MutatingWebhook, 0, len(selectors))
+	for _, s := range selectors {
+		wh := reconciled.DeepCopy()
+		if s.prefix == "" {
+			// keep the expressions managed by psbinding in front, as it does
+			expressions := append(knativeExpressions(reconciled.NamespaceSelector), s.namespace...)
+			wh.NamespaceSelector = &metav1.LabelSelector{MatchExpressions: expressions}
+			wh.ObjectSelector = wh.NamespaceSelector.DeepCopy()
+		} else {
+			wh.Name = fmt.Sprintf("%s.%s", s.prefix, current.Name)
+			wh.NamespaceSelector = &metav1.LabelSelector{MatchExpressions: s.namespace}
+			wh.ObjectSelector = &metav1.LabelSelector{MatchExpressions: s.object}
+		}
+		webhooks = append(webhooks, *wh)
+	}
+	current.Webhooks = webhooks
+
+	if ok := equality.Semantic.DeepEqual(configuredWebhook, current); !ok {
+		logging.FromContext(ctx).Info("Updating webhook selectors")
+		mwhclient := ac.Client.AdmissionregistrationV1().MutatingWebhookConfigurations()
+		if _, err := mwhclient.Update(ctx, current, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update webhook: %w", err)
+		}
+	}
+	return nil
+}
+
+// knativeExpressions returns the expressions of the selector that psbinding
+// manages, on keys containing knative.dev.
+func knativeExpressions(selector *metav1.LabelSelector) []metav1.LabelSelectorRequirement {
+	expressions := []metav1.LabelSelectorRequirement{}
+	if selector == nil {
+		return expressions
+	}
+	for _, e := range selector.MatchExpressions {
+		if strings.Contains(e.Key, "knative.dev") {
+			expressions = append(expressions, e)
+		}
+	}
+	return expressions
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	admissionregistrationv1listers "k8s.io/client-go/listers/admissionregistration/v1"
+	"k8s.io/client-go/tools/cache"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/webhook/psbinding"
+
+	"github.com/vmware-tanzu/servicebinding/pkg/config"
+)
+
+func TestWebhookSelectors(t *testing.T) {
+	allLabels := []string{
+		config.BindingExcludeLabel,
+		config.BindingIncludeLabel,
+		config.LegacyBindingExcludeLabel,
+		config.LegacyBindingIncludeLabel,
+	}
+	// every combination of the labels being set to "true", "false" or unset
+	combinations := []map[string]string{{}}
+	for _, label := range allLabels {
+		next := []map[string]string{}
+		for _, c := range combinations {
+			for _, value := range []string{"", "true", "false"} {
+				l := map[string]string{}
+				for k, v := range c {
+					l[k] = v
+				}
+				if value != "" {
+					l[label] = value
+				}
+				next = append(next, l)
+			}
+		}
+		combinations = next
+	}
+
+	for _, mode := range []string{config.SelectionModeExclusion, config.SelectionModeInclusion} {
+		t.Run(mode, func(t *testing.T) {
+			type selector struct {
+				prefix    string
+				namespace labels.Selector
+				object    labels.Selector
+			}
+			selectors := []selector{}
+			for i, s := range webhookSelectors(mode) {
+				namespace, object := s.namespace, s.object
+				if i == 0 {
+					// added by psbinding from the ExclusionSelector of the manager
+					namespace = append([]metav1.LabelSelectorRequirement{notLabeled(config.LegacyBindingExcludeLabel)}, namespace...)
+					object = namespace
+				}
+				ns, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchExpressions: namespace})
+				if err != nil {
+					t.Fatalf("LabelSelectorAsSelector() unexpected err %v", err)
+				}
+				obj, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchExpressions: object})
+				if err != nil {
+					t.Fatalf("LabelSelectorAsSelector() unexpected err %v", err)
+				}
+				selectors = append(selectors, selector{prefix: s.prefix, namespace: ns, object: obj})
+			}
+
+			binding := &config.Binding{SelectionMode: mode}
+			for _, nsLabels := range combinations {
+				for _, objLabels := range combinations {
+					matched := []string{}
+					for _, s := range selectors {
+						if s.namespace.Matches(labels.Set(nsLabels)) && s.object.Matches(labels.Set(objLabels)) {
+							matched = append(matched, s.prefix)
+						}
+					}
+					ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Labels: nsLabels}}
+					expected := 0
+					if binding.Selects(ns, objLabels) {
+						expected = 1
+					}
+					if len(matched) != expected {
+						t.Errorf("namespace %v, object %v: expected %d webhooks, got %q", nsLabels, objLabels, expected, matched)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileWebhookSelectors(t *testing.T) {
+	name := "servicebindingprojections.webhook.bindings.labs.vmware.com"
+	legacyExcluded := notLabeled(config.LegacyBindingExcludeLabel)
+	excluded := notLabeled(config.BindingExcludeLabel)
+	rules := []admissionregistrationv1.RuleWithOperations{{
+		Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{"apps"},
+			APIVersions: []string{"v1"},
+			Resources:   []string{"deployments/*"},
+		},
+	}}
+	// as reconciled by psbinding
+	reconciled := admissionregistrationv1.MutatingWebhook{
+		Name:  name,
+		Rules: rules,
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{legacyExcluded, excluded},
+		},
+		ObjectSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{legacyExcluded, excluded},
+		},
+	}
+	included := reconciled.DeepCopy()
+	included.NamespaceSelector.MatchExpressions = append(included.NamespaceSelector.MatchExpressions, labeled(config.BindingIncludeLabel))
+	included.ObjectSelector.MatchExpressions = append(included.ObjectSelector.MatchExpressions, labeled(config.BindingIncludeLabel))
+	inclusionWebhooks := []admissionregistrationv1.MutatingWebhook{*included}
+	for _, s := range webhookSelectors(config.SelectionModeInclusion)[1:] {
+		wh := reconciled.DeepCopy()
+		wh.Name = s.prefix + "." + name
+		wh.NamespaceSelector = &metav1.LabelSelector{MatchExpressions: s.namespace}
+		wh.ObjectSelector = &metav1.LabelSelector{MatchExpressions: s.object}
+		inclusionWebhooks = append(inclusionWebhooks, *wh)
+	}
+
+	tests := []struct {
+		name          string
+		selectionMode string
+		webhooks      []admissionregistrationv1.MutatingWebhook
+		expected      []admissionregistrationv1.MutatingWebhook
+	}{
+		{
+			name:          "exclusion mode",
+			selectionMode: config.SelectionModeExclusion,
+			webhooks:      []admissionregistrationv1.MutatingWebhook{reconciled},
+		},
+		{
+			name:          "inclusion mode",
+			selectionMode: config.SelectionModeInclusion,
+			webhooks:      []admissionregistrationv1.MutatingWebhook{reconciled},
+			expected:      inclusionWebhooks,
+		},
+		{
+			name:          "inclusion mode, up to date",
+			selectionMode: config.SelectionModeInclusion,
+			webhooks:      inclusionWebhooks,
+		},
+		{
+			name:          "back to exclusion mode",
+			selectionMode: config.SelectionModeExclusion,
+			webhooks:      inclusionWebhooks,
+			expected:      []admissionregistrationv1.MutatingWebhook{reconciled},
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			configStore := config.NewStore(logtesting.TestLogger(t))
+			configStore.OnConfigChanged(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: config.BindingConfigName},
+				Data: map[string]string{
+					"selection-mode": c.selectionMode,
+				},
+			})
+			mwh := &admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Webhooks:   c.webhooks,
+			}
+			client := fake.NewSimpleClientset(mwh)
+			mwhIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			mwhIndexer.Add(mwh)
+
+			ac := &Reconciler{
+				Reconciler: &psbinding.Reconciler{
+					Name:      name,
+					Client:    client,
+					MWHLister: admissionregistrationv1listers.NewMutatingWebhookConfigurationLister(mwhIndexer),
+				},
+				configStore: configStore,
+			}
+
+			if err := ac.reconcileWebhookSelectors(ctx); err != nil {
+				t.Fatalf("reconcileWebhookSelectors() unexpected err %v", err)
+			}
+
+			updates := 0
+			for _, action := range client.Actions() {
+				if action.GetVerb() == "update" {
+					updates++
+				}
+			}
+			if c.expected == nil {
+				if updates != 0 {
+					t.Errorf("reconcileWebhookSelectors() expected no update, got %d", updates)
+				}
+				return
+			}
+			actual, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Get() unexpected err %v", err)
+			}
+			if diff := cmp.Diff(c.expected, actual.Webhooks); diff != "" {
+				t.Errorf("reconcileWebhookSelectors() webhooks (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
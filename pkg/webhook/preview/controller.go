@@ -0,0 +1,163 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preview
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	"knative.dev/pkg/webhook"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+	"knative.dev/pkg/webhook/psbinding"
+)
+
+// NewController creates a controller loading the certificate of the webhook
+// into a Server, and runs the Server on the port until the context is done.
+// Previews are served over TLS with the same certificate as the webhook, so
+// the bearer tokens of callers are never sent in the clear.
+func NewController(ctx context.Context, port int, withContext psbinding.BindableContext) *controller.Impl {
+	logger := logging.FromContext(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	secretName := webhook.GetOptions(ctx).SecretName
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, NewHandler(dynamicclient.Get(ctx), kubeclient.Get(ctx), withContext))
+	server := NewServer(port, mux)
+
+	r := &Reconciler{
+		secretLister: secretInformer.Lister(),
+		secretName:   secretName,
+		server:       server,
+	}
+	impl := controller.NewImpl(r, logger, "preview")
+
+	logger.Info("Setting up event handlers")
+
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithNameAndNamespace(system.Namespace(), secretName),
+		Handler:    controller.HandleAll(impl.Enqueue),
+	})
+
+	go func() {
+		if err := server.Run(ctx.Done()); err != nil {
+			logger.Errorw("Error serving previews", zap.Error(err))
+		}
+	}()
+
+	return impl
+}
+
+// Reconciler loads the certificate of the webhook into the Server. Every
+// replica serves previews, so the certificate is loaded whether or not the
+// replica is the leader.
+type Reconciler struct {
+	pkgreconciler.LeaderAwareFuncs
+
+	secretLister corev1listers.SecretLister
+	secretName   string
+	server       *Server
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+var _ pkgreconciler.LeaderAware = (*Reconciler)(nil)
+
+// Reconcile implements controller.Reconciler
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	secret, err := r.secretLister.Secrets(system.Namespace()).Get(r.secretName)
+	if apierrs.IsNotFound(err) {
+		// the certificate is created by the webhook's certificate controller
+		return nil
+	} else if err != nil {
+		return err
+	}
+	serverKey, ok := secret.Data[certresources.ServerKey]
+	if !ok {
+		return fmt.Errorf("secret %q is missing %q key", r.secretName, certresources.ServerKey)
+	}
+	serverCert, ok := secret.Data[certresources.ServerCert]
+	if !ok {
+		return fmt.Errorf("secret %q is missing %q key", r.secretName, certresources.ServerCert)
+	}
+	cert, err := tls.X509KeyPair(serverCert, serverKey)
+	if err != nil {
+		return err
+	}
+	r.server.SetCertificate(&cert)
+	return nil
+}
+
+// Server serves previews over TLS. Connections are refused until a
+// certificate is set.
+type Server struct {
+	port    int
+	handler http.Handler
+
+	m    sync.RWMutex
+	cert *tls.Certificate
+}
+
+// NewServer creates a Server for the handler on the port.
+func NewServer(port int, handler http.Handler) *Server {
+	return &Server{
+		port:    port,
+		handler: handler,
+	}
+}
+
+// SetCertificate replaces the certificate presented to new connections.
+func (s *Server) SetCertificate(cert *tls.Certificate) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.cert = cert
+}
+
+func (s *Server) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.cert == nil {
+		return nil, errors.New("no serving certificate loaded")
+	}
+	return s.cert, nil
+}
+
+// Run serves until stop is closed, then shuts the server down gracefully.
+func (s *Server) Run(stop <-chan struct{}) error {
+	server := &http.Server{
+		Addr:    ":" + strconv.Itoa(s.port),
+		Handler: s.handler,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: s.getCertificate,
+		},
+	}
+	go func() {
+		<-stop
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}()
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preview
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/system"
+	_ "knative.dev/pkg/system/testing"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+func TestReconciler(t *testing.T) {
+	serverKey, serverCert, _, err := certresources.CreateCerts(context.TODO(), "webhook", system.Namespace(), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateCerts() unexpected err %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		seed         []*corev1.Secret
+		expectedErr  bool
+		expectedCert bool
+	}{
+		{
+			name: "secret not created",
+		},
+		{
+			name: "load certificate",
+			seed: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: "webhook-certs"},
+					Data: map[string][]byte{
+						certresources.ServerKey:  serverKey,
+						certresources.ServerCert: serverCert,
+					},
+				},
+			},
+			expectedCert: true,
+		},
+		{
+			name: "missing key",
+			seed: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: "webhook-certs"},
+					Data: map[string][]byte{
+						certresources.ServerCert: serverCert,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid certificate",
+			seed: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: "webhook-certs"},
+					Data: map[string][]byte{
+						certresources.ServerKey:  serverKey,
+						certresources.ServerCert: []byte("not a certificate"),
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, s := range c.seed {
+				indexer.Add(s)
+			}
+			server := NewServer(0, http.NotFoundHandler())
+			r := &Reconciler{
+				secretLister: corev1listers.NewSecretLister(indexer),
+				secretName:   "webhook-certs",
+				server:       server,
+			}
+
+			err := r.Reconcile(context.TODO(), system.Namespace()+"/webhook-certs")
+			if (err != nil) != c.expectedErr {
+				t.Errorf("Reconcile() expected err %v, got %v", c.expectedErr, err)
+			}
+			cert, err := server.getCertificate(nil)
+			if (cert != nil) != c.expectedCert {
+				t.Errorf("getCertificate() expected certificate %v, got %v", c.expectedCert, err)
+			}
+		})
+	}
+}
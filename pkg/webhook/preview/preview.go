@@ -0,0 +1,449 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/tracker"
+	"knative.dev/pkg/webhook/psbinding"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+	servicebindingv1beta1 "github.com/vmware-tanzu/servicebinding/pkg/apis/servicebinding/v1beta1"
+	"github.com/vmware-tanzu/servicebinding/pkg/projector"
+	"github.com/vmware-tanzu/servicebinding/pkg/resolver"
+)
+
+const (
+	// Path is the path previews are served on
+	Path = "/preview"
+	// maxRequestBytes limits the size of the ServiceBinding in a request
+	maxRequestBytes = 1 << 20
+)
+
+// Preview is the response to a preview request.
+type Preview struct {
+	// Workloads are the workload resources matched by the ServiceBinding
+	Workloads []Workload `json:"workloads"`
+}
+
+// Workload is a workload resource with the ServiceBinding applied.
+type Workload struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	// Template is the pod template of the workload as it would be mutated
+	// by the binding webhook
+	Template duckv1.PodSpecable `json:"template"`
+}
+
+// Handler previews the result of creating a ServiceBinding. The request body
+// is a ServiceBinding, the response describes each workload it would be
+// applied to. The service and workloads are read from the cluster, nothing
+// is persisted.
+//
+// Requests are authenticated with the bearer token of the caller, who must be
+// allowed to create the ServiceBinding and to read the services and workloads.
+type Handler struct {
+	client      dynamic.Interface
+	kubeclient  kubernetes.Interface
+	mapper      *resolver.ResourceMapper
+	withContext psbinding.BindableContext
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler creates a Handler. The context of each projection is setup with
+// withContext, like the binding webhook.
+func NewHandler(client dynamic.Interface, kubeclient kubernetes.Interface, withContext psbinding.BindableContext) *Handler {
+	return &Handler{
+		client:      client,
+		kubeclient:  kubeclient,
+		mapper:      resolver.NewResourceMapper(kubeclient.Discovery()),
+		withContext: withContext,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "preview requires a POST request", http.StatusMethodNotAllowed)
+		return
+	}
+	objs, err := projector.Decode(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(objs) != 1 || objs[0].GroupVersionKind().GroupKind() != servicebindingv1beta1.Kind("ServiceBinding") {
+		http.Error(w, "preview requires a single ServiceBinding", http.StatusBadRequest)
+		return
+	}
+	binding := objs[0]
+	namespace := binding.GetNamespace()
+	if namespace == "" {
+		namespace = r.URL.Query().Get("namespace")
+	}
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	binding.SetNamespace(namespace)
+
+	user, err := h.authenticate(r)
+	if err != nil {
+		logging.FromContext(r.Context()).Errorw("Error authenticating preview request", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if user == nil {
+		http.Error(w, "preview requires a valid bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	preview, err := h.preview(r.Context(), user, binding)
+	if err != nil {
+		var badRequest *badRequestError
+		if errors.As(err, &badRequest) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var forbidden *forbiddenError
+		if errors.As(err, &forbidden) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		logging.FromContext(r.Context()).Errorw("Error previewing ServiceBinding", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(preview)
+}
+
+func (h *Handler) preview(ctx context.Context, user *authenticationv1.UserInfo, binding *unstructured.Unstructured) (*Preview, error) {
+	namespace := binding.GetNamespace()
+	objs := []*unstructured.Unstructured{binding}
+
+	if err := h.authorize(ctx, user, authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "create",
+		Group:     servicebindingv1beta1.GroupName,
+		Resource:  "servicebindings",
+	}); err != nil {
+		return nil, err
+	}
+
+	service, err := reference(binding, "service")
+	if err != nil {
+		return nil, err
+	}
+	services, err := boundServices(binding)
+	if err != nil {
+		return nil, err
+	}
+	if service != nil {
+		if service.Namespace == "" {
+			service.Namespace = namespace
+		}
+		services = append([]*tracker.Reference{service}, services...)
+	}
+	for _, service := range services {
+		if service.APIVersion == "v1" && (service.Kind == "Secret" || service.Kind == "ConfigMap") {
+			continue
+		}
+		if service.Namespace != namespace {
+			// the projector does not read services in another namespace,
+			// their secret is copied by the controller once granted
+			continue
+		}
+		gvr, err := h.resource(service.APIVersion, service.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.authorize(ctx, user, authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      "get",
+			Group:     gvr.Group,
+			Version:   gvr.Version,
+			Resource:  gvr.Resource,
+			Name:      service.Name,
+		}); err != nil {
+			return nil, err
+		}
+		// a missing service is reported by the projector
+		obj, err := h.get(ctx, service.APIVersion, service.Kind, namespace, service.Name)
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+
+	workload, err := reference(binding, "workload")
+	if err != nil {
+		return nil, err
+	}
+	if workload != nil {
+		gvr, err := h.resource(workload.APIVersion, workload.Kind)
+		if err != nil {
+			return nil, err
+		}
+		verb := "list"
+		if workload.Name != "" {
+			verb = "get"
+		}
+		if err := h.authorize(ctx, user, authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     gvr.Group,
+			Version:   gvr.Version,
+			Resource:  gvr.Resource,
+			Name:      workload.Name,
+		}); err != nil {
+			return nil, err
+		}
+		workloads, err := h.workloads(ctx, workload, namespace)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, workloads...)
+		if len(workloads) != 0 {
+			mapping, err := h.mapping(ctx, workloads[0].GroupVersionKind())
+			if err != nil {
+				return nil, err
+			}
+			if mapping != nil {
+				objs = append(objs, mapping)
+			}
+		}
+	}
+
+	ctx, err = h.withContext(ctx, &labsinternalv1alpha1.ServiceBindingProjection{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+	})
+	if err != nil {
+		return nil, err
+	}
+	projections, err := projector.Project(ctx, namespace, objs, h.mapper)
+	if err != nil {
+		return nil, &badRequestError{err: err}
+	}
+
+	preview := &Preview{Workloads: []Workload{}}
+	for _, p := range projections {
+		preview.Workloads = append(preview.Workloads, Workload{
+			APIVersion: p.Original.GetAPIVersion(),
+			Kind:       p.Original.GetKind(),
+			Name:       p.Original.GetName(),
+			Template:   p.Template,
+		})
+	}
+	return preview, nil
+}
+
+// authenticate reviews the bearer token of the request, returning the user it
+// belongs to, or nil when the token is missing or not valid.
+func (h *Handler) authenticate(r *http.Request) (*authenticationv1.UserInfo, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return nil, nil
+	}
+	review, err := h.kubeclient.AuthenticationV1().TokenReviews().Create(r.Context(), &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !review.Status.Authenticated {
+		return nil, nil
+	}
+	return &review.Status.User, nil
+}
+
+// authorize checks the user is allowed to access the resource.
+func (h *Handler) authorize(ctx context.Context, user *authenticationv1.UserInfo, attributes authorizationv1.ResourceAttributes) error {
+	extra := map[string]authorizationv1.ExtraValue{}
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	review, err := h.kubeclient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &attributes,
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+			Extra:              extra,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if !review.Status.Allowed {
+		return &forbiddenError{user: user.Username, attributes: attributes}
+	}
+	return nil
+}
+
+// reference reads a reference from the spec of the ServiceBinding. A missing
+// reference is reported by the projector when the binding is validated.
+func reference(binding *unstructured.Unstructured, field string) (*tracker.Reference, error) {
+	m, ok, _ := unstructured.NestedMap(binding.Object, "spec", field)
+	if !ok {
+		return nil, nil
+	}
+	ref := &tracker.Reference{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, ref); err != nil {
+		return nil, &badRequestError{err: fmt.Errorf("invalid spec.%s: %w", field, err)}
+	}
+	if ref.APIVersion == "" || ref.Kind == "" {
+		return nil, nil
+	}
+	return ref, nil
+}
+
+// boundServices reads the references of the additional services from the
+// spec of the ServiceBinding. Additional services are always in the namespace
+// of the binding. Invalid entries are reported by the projector.
+func boundServices(binding *unstructured.Unstructured) ([]*tracker.Reference, error) {
+	entries, _, _ := unstructured.NestedSlice(binding.Object, "spec", "services")
+	refs := []*tracker.Reference{}
+	for i, entry := range entries {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service, ok := m["service"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ref := &tracker.Reference{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(service, ref); err != nil {
+			return nil, &badRequestError{err: fmt.Errorf("invalid spec.services[%d].service: %w", i, err)}
+		}
+		if ref.APIVersion == "" || ref.Kind == "" {
+			continue
+		}
+		ref.Namespace = binding.GetNamespace()
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// workloads reads the workload resources matched by the reference, by name
+// or by label selector.
+func (h *Handler) workloads(ctx context.Context, ref *tracker.Reference, namespace string) ([]*unstructured.Unstructured, error) {
+	if ref.Name != "" {
+		obj, err := h.get(ctx, ref.APIVersion, ref.Kind, namespace, ref.Name)
+		if err != nil || obj == nil {
+			return nil, err
+		}
+		return []*unstructured.Unstructured{obj}, nil
+	}
+	if ref.Selector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+	if err != nil {
+		return nil, &badRequestError{err: fmt.Errorf("invalid spec.workload.selector: %w", err)}
+	}
+	gvr, err := h.resource(ref.APIVersion, ref.Kind)
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.client.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	workloads := []*unstructured.Unstructured{}
+	for i := range list.Items {
+		workloads = append(workloads, &list.Items[i])
+	}
+	return workloads, nil
+}
+
+// mapping reads the ClusterWorkloadResourceMapping for the workload kind, if
+// any.
+func (h *Handler) mapping(ctx context.Context, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	gvr, err := h.mapper.ResourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+	return h.get(ctx, servicebindingv1beta1.SchemeGroupVersion.String(), "ClusterWorkloadResourceMapping", "", gvr.GroupResource().String())
+}
+
+// get reads a resource, returning nil when it does not exist.
+func (h *Handler) get(ctx context.Context, apiVersion, kind, namespace, name string) (*unstructured.Unstructured, error) {
+	gvr, err := h.resource(apiVersion, kind)
+	if err != nil {
+		return nil, err
+	}
+	var obj *unstructured.Unstructured
+	if namespace == "" {
+		obj, err = h.client.Resource(gvr).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		obj, err = h.client.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	if apierrs.IsNotFound(err) {
+		return nil, nil
+	}
+	return obj, err
+}
+
+// resource resolves the resource serving the kind. A kind the cluster does
+// not serve is a bad request.
+func (h *Handler) resource(apiVersion, kind string) (schema.GroupVersionResource, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, &badRequestError{err: err}
+	}
+	gvr, err := h.mapper.ResourceFor(gv.WithKind(kind))
+	if resolver.IsKindNotServed(err) {
+		return schema.GroupVersionResource{}, &badRequestError{err: err}
+	}
+	return gvr, err
+}
+
+// badRequestError is an error caused by the content of the request
+type badRequestError struct {
+	err error
+}
+
+func (e *badRequestError) Error() string {
+	return e.err.Error()
+}
+
+func (e *badRequestError) Unwrap() error {
+	return e.err
+}
+
+// forbiddenError is an error caused by the user not being allowed to access
+// a resource
+type forbiddenError struct {
+	user       string
+	attributes authorizationv1.ResourceAttributes
+}
+
+func (e *forbiddenError) Error() string {
+	a := e.attributes
+	return fmt.Sprintf("user %q cannot %s resource %q in API group %q in the namespace %q", e.user, a.Verb, a.Resource, a.Group, a.Namespace)
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2020 VMware, Inc.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package preview
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/webhook/psbinding"
+	"sigs.k8s.io/yaml"
+
+	labsinternalv1alpha1 "github.com/vmware-tanzu/servicebinding/pkg/apis/labsinternal/v1alpha1"
+)
+
+const bindingYAML = `
+apiVersion: servicebinding.io/v1beta1
+kind: ServiceBinding
+metadata:
+  name: my-binding
+  namespace: my-namespace
+spec:
+  service:
+    apiVersion: bindings.labs.vmware.com/v1alpha1
+    kind: ProvisionedService
+    name: my-service
+  workload:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: my-workload
+`
+
+const provisionedServiceYAML = `
+apiVersion: bindings.labs.vmware.com/v1alpha1
+kind: ProvisionedService
+metadata:
+  name: my-service
+  namespace: my-namespace
+status:
+  binding:
+    name: my-secret
+`
+
+const deploymentYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-workload
+  namespace: my-namespace
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: my-image
+`
+
+func mustUnstructured(t *testing.T, doc string) runtime.Object {
+	t.Helper()
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(doc), &obj.Object); err != nil {
+		t.Fatalf("Unmarshal() unexpected err %v", err)
+	}
+	return obj
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		token          string
+		body           string
+		deny           string
+		mountRoot      string
+		expectedStatus int
+		expectedMounts map[string]string
+		expectedSecret string
+	}{
+		{
+			name:           "preview",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           bindingYAML,
+			expectedStatus: http.StatusOK,
+			expectedMounts: map[string]string{
+				"app": "/bindings/my-binding",
+			},
+		},
+		{
+			name:           "preview with namespace mount root",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           bindingYAML,
+			mountRoot:      "/platform/bindings",
+			expectedStatus: http.StatusOK,
+			expectedMounts: map[string]string{
+				"app": "/platform/bindings/my-binding",
+			},
+		},
+		{
+			name:           "preview without matching workloads",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           strings.Replace(bindingYAML, "name: my-workload", "name: other-workload", 1),
+			expectedStatus: http.StatusOK,
+			expectedMounts: map[string]string{},
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			token:          "valid",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "missing token",
+			method:         http.MethodPost,
+			body:           bindingYAML,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid token",
+			method:         http.MethodPost,
+			token:          "invalid",
+			body:           bindingYAML,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden to create bindings",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           bindingYAML,
+			deny:           "servicebindings",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "forbidden to get services",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           bindingYAML,
+			deny:           "provisionedservices",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "service in another namespace is not read",
+			method: http.MethodPost,
+			token:  "valid",
+			body: strings.Replace(bindingYAML, "name: my-service", `name: my-service
+    namespace: other-namespace`, 1),
+			deny:           "provisionedservices",
+			expectedStatus: http.StatusOK,
+			expectedMounts: map[string]string{
+				"app": "/bindings/my-binding",
+			},
+			expectedSecret: "my-binding-copied",
+		},
+		{
+			name:           "forbidden to get workloads",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           bindingYAML,
+			deny:           "deployments",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "not a binding",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           deploymentYAML,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "workload kind not served",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           strings.Replace(bindingYAML, "kind: Deployment", "kind: Widget", 1),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing service",
+			method:         http.MethodPost,
+			token:          "valid",
+			body:           strings.Replace(bindingYAML, "name: my-service", "name: other-service", 1),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				mustUnstructured(t, provisionedServiceYAML),
+				mustUnstructured(t, deploymentYAML),
+			)
+			kubeclient := kubefake.NewSimpleClientset()
+			kubeclient.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "apps/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "deployments", Namespaced: true, Kind: "Deployment"},
+					},
+				},
+				{
+					GroupVersion: "bindings.labs.vmware.com/v1alpha1",
+					APIResources: []metav1.APIResource{
+						{Name: "provisionedservices", Namespaced: true, Kind: "ProvisionedService"},
+					},
+				},
+				{
+					GroupVersion: "servicebinding.io/v1beta1",
+					APIResources: []metav1.APIResource{
+						{Name: "clusterworkloadresourcemappings", Kind: "ClusterWorkloadResourceMapping"},
+					},
+				},
+			}
+			kubeclient.PrependReactor("create", "tokenreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				review := action.(clientgotesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+				review.Status.Authenticated = review.Spec.Token == "valid"
+				review.Status.User = authenticationv1.UserInfo{Username: "developer"}
+				return true, review, nil
+			})
+			kubeclient.PrependReactor("create", "subjectaccessreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				review := action.(clientgotesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				review.Status.Allowed = review.Spec.User == "developer" && review.Spec.ResourceAttributes.Resource != c.deny
+				return true, review, nil
+			})
+			var withContext psbinding.BindableContext = func(ctx context.Context, fb psbinding.Bindable) (context.Context, error) {
+				if c.mountRoot != "" {
+					ctx = labsinternalv1alpha1.WithMountRoot(ctx, c.mountRoot)
+				}
+				return ctx, nil
+			}
+
+			req := httptest.NewRequest(c.method, Path, strings.NewReader(c.body))
+			if c.token != "" {
+				req.Header.Set("Authorization", "Bearer "+c.token)
+			}
+			rec := httptest.NewRecorder()
+			NewHandler(client, kubeclient, withContext).ServeHTTP(rec, req)
+
+			if rec.Code != c.expectedStatus {
+				t.Fatalf("ServeHTTP() expected status %d, got %d: %s", c.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if c.expectedMounts == nil {
+				return
+			}
+			if c.expectedSecret == "" {
+				c.expectedSecret = "my-secret"
+			}
+			preview := &Preview{}
+			if err := json.Unmarshal(rec.Body.Bytes(), preview); err != nil {
+				t.Fatalf("Unmarshal() unexpected err %v", err)
+			}
+			actual := map[string]string{}
+			for _, w := range preview.Workloads {
+				for _, container := range w.Template.Spec.Containers {
+					for _, vm := range container.VolumeMounts {
+						actual[container.Name] = vm.MountPath
+					}
+				}
+				if diff := cmp.Diff(corev1.LocalObjectReference{Name: c.expectedSecret}, w.Template.Spec.Volumes[0].Projected.Sources[0].Secret.LocalObjectReference); diff != "" {
+					t.Errorf("ServeHTTP() projected secret (-expected, +actual): %s", diff)
+				}
+			}
+			if diff := cmp.Diff(c.expectedMounts, actual); diff != "" {
+				t.Errorf("ServeHTTP() volume mounts (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}